@@ -8,8 +8,8 @@ package goscale
 */
 
 import (
-	"bytes"
 	"errors"
+	"io"
 )
 
 type Bool bool
@@ -18,8 +18,8 @@ var (
 	errInvalidBoolRepresentation = errors.New("invalid bool representation")
 )
 
-func (value Bool) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (value Bool) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(value.Bytes())
 }
 
@@ -31,8 +31,16 @@ func (value Bool) Bytes() []byte {
 	return buf
 }
 
-func DecodeBool(buffer *bytes.Buffer) (Bool, error) {
-	decoder := Decoder{Reader: buffer}
+func (value Bool) EncodedLen() int {
+	return 1
+}
+
+func (value Bool) MaxEncodedLen() int {
+	return 1
+}
+
+func DecodeBool(reader io.Reader) (Bool, error) {
+	decoder := Decoder{Reader: reader, Type: "Bool"}
 	result, err := decoder.DecodeByte()
 	if err != nil {
 		return false, err
@@ -46,3 +54,12 @@ func DecodeBool(buffer *bytes.Buffer) (Bool, error) {
 		return false, errInvalidBoolRepresentation
 	}
 }
+
+func (value *Bool) Decode(reader io.Reader) error {
+	result, err := DecodeBool(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
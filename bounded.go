@@ -0,0 +1,229 @@
+package goscale
+
+/*
+	Ref: https://docs.rs/bounded-collections/latest/bounded_collections/
+
+	BoundedSequence and BoundedDictionary are encoded like Sequence and Dictionary,
+	but their length can not exceed the bound, neither when they are built nor
+	when they are encoded or decoded. WeakBoundedSequence checks the bound only
+	when it is built, the longer values are decoded.
+*/
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	errBoundExceeded = errors.New("length exceeds the bound")
+)
+
+// Bound provides the maximum length of the bounded collections, it is implemented
+// by an empty type, e.g. `type Max16 struct{}` with `func (Max16) Bound() int { return 16 }`.
+type Bound interface {
+	Bound() int
+}
+
+// allows reflection based codecs to check the bound of the collection
+type bounded interface {
+	bound() int
+}
+
+type BoundedSequence[T Encodable, B Bound] []T
+
+// NewBoundedSequence fails if there are more values than the bound.
+func NewBoundedSequence[T Encodable, B Bound](values ...T) (BoundedSequence[T, B], error) {
+	seq := BoundedSequence[T, B](values)
+	if len(seq) > seq.bound() {
+		return nil, errBoundExceeded
+	}
+	return seq, nil
+}
+
+// TryPush appends value, it fails if the sequence is full.
+func (seq *BoundedSequence[T, B]) TryPush(value T) error {
+	if len(*seq) >= seq.bound() {
+		return errBoundExceeded
+	}
+	*seq = append(*seq, value)
+	return nil
+}
+
+func (seq BoundedSequence[T, B]) Encode(writer io.Writer) error {
+	if len(seq) > seq.bound() {
+		return errBoundExceeded
+	}
+	return Sequence[T](seq).Encode(writer)
+}
+
+func (seq BoundedSequence[T, B]) Bytes() []byte {
+	return EncodedBytes(seq)
+}
+
+func (seq BoundedSequence[T, B]) EncodedLen() int {
+	return Sequence[T](seq).EncodedLen()
+}
+
+func (seq BoundedSequence[T, B]) maxEncodedLen() (int, bool) {
+	n, ok := MaxEncodedLen[T]()
+	return compactEncodedLen(uint64(seq.bound())) + seq.bound()*n, ok
+}
+
+func (seq BoundedSequence[T, B]) bound() int {
+	return (*new(B)).Bound()
+}
+
+// DecodeBoundedSequence fails before decoding the elements if the length exceeds the bound.
+func DecodeBoundedSequence[T Encodable, B Bound](reader io.Reader) (BoundedSequence[T, B], error) {
+	size, err := decodeBoundedLength(reader, BoundedSequence[T, B]{}.bound())
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeSequence[T](reader, size)
+	if err != nil {
+		return nil, err
+	}
+	return BoundedSequence[T, B](result), nil
+}
+
+func (seq *BoundedSequence[T, B]) Decode(reader io.Reader) error {
+	result, err := DecodeBoundedSequence[T, B](reader)
+	if err != nil {
+		return err
+	}
+	*seq = result
+	return nil
+}
+
+type WeakBoundedSequence[T Encodable, B Bound] []T
+
+// NewWeakBoundedSequence fails if there are more values than the bound.
+func NewWeakBoundedSequence[T Encodable, B Bound](values ...T) (WeakBoundedSequence[T, B], error) {
+	seq, err := NewBoundedSequence[T, B](values...)
+	return WeakBoundedSequence[T, B](seq), err
+}
+
+// TryPush appends value, it fails if the sequence is full.
+func (seq *WeakBoundedSequence[T, B]) TryPush(value T) error {
+	return (*BoundedSequence[T, B])(seq).TryPush(value)
+}
+
+func (seq WeakBoundedSequence[T, B]) Encode(writer io.Writer) error {
+	return Sequence[T](seq).Encode(writer)
+}
+
+func (seq WeakBoundedSequence[T, B]) Bytes() []byte {
+	return EncodedBytes(seq)
+}
+
+func (seq WeakBoundedSequence[T, B]) EncodedLen() int {
+	return Sequence[T](seq).EncodedLen()
+}
+
+// DecodeWeakBoundedSequence accepts the sequences longer than the bound.
+func DecodeWeakBoundedSequence[T Encodable, B Bound](reader io.Reader) (WeakBoundedSequence[T, B], error) {
+	result, err := DecodeSequence[T](reader)
+	if err != nil {
+		return nil, err
+	}
+	return WeakBoundedSequence[T, B](result), nil
+}
+
+func (seq *WeakBoundedSequence[T, B]) Decode(reader io.Reader) error {
+	result, err := DecodeWeakBoundedSequence[T, B](reader)
+	if err != nil {
+		return err
+	}
+	*seq = result
+	return nil
+}
+
+type BoundedDictionary[K Comparable, V Encodable, B Bound] map[K]V
+
+// NewBoundedDictionary fails if there are more entries than the bound.
+func NewBoundedDictionary[K Comparable, V Encodable, B Bound](entries map[K]V) (BoundedDictionary[K, V, B], error) {
+	d := BoundedDictionary[K, V, B](entries)
+	if len(d) > d.bound() {
+		return nil, errBoundExceeded
+	}
+	return d, nil
+}
+
+// TryInsert sets the value of key, it fails if the key is new and the dictionary is full.
+func (d *BoundedDictionary[K, V, B]) TryInsert(key K, value V) error {
+	if _, ok := (*d)[key]; !ok && len(*d) >= d.bound() {
+		return errBoundExceeded
+	}
+	if *d == nil {
+		*d = BoundedDictionary[K, V, B]{}
+	}
+	(*d)[key] = value
+	return nil
+}
+
+func (d BoundedDictionary[K, V, B]) Encode(writer io.Writer) error {
+	if len(d) > d.bound() {
+		return errBoundExceeded
+	}
+	return Dictionary[K, V](d).Encode(writer)
+}
+
+func (d BoundedDictionary[K, V, B]) Bytes() []byte {
+	return EncodedBytes(d)
+}
+
+func (d BoundedDictionary[K, V, B]) EncodedLen() int {
+	return Dictionary[K, V](d).EncodedLen()
+}
+
+func (d BoundedDictionary[K, V, B]) maxEncodedLen() (int, bool) {
+	key, keyOk := MaxEncodedLen[K]()
+	value, valueOk := MaxEncodedLen[V]()
+	return compactEncodedLen(uint64(d.bound())) + d.bound()*(key+value), keyOk && valueOk
+}
+
+// allows reflection based codecs to order the keys of BoundedDictionary[K, V, B]
+func (d BoundedDictionary[K, V, B]) lessKeys(a, b any) bool {
+	return lessKey(a.(K), b.(K))
+}
+
+func (d BoundedDictionary[K, V, B]) keysOrdered() bool {
+	return checkKeyOrder[K]() == nil
+}
+
+func (d BoundedDictionary[K, V, B]) bound() int {
+	return (*new(B)).Bound()
+}
+
+// DecodeBoundedDictionary fails before decoding the entries if the length exceeds the bound.
+func DecodeBoundedDictionary[K Comparable, V Encodable, B Bound](reader io.Reader) (BoundedDictionary[K, V, B], error) {
+	size, err := decodeBoundedLength(reader, BoundedDictionary[K, V, B]{}.bound())
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeDictionary[K, V](reader, size, isStrict(reader))
+	if err != nil {
+		return nil, err
+	}
+	return BoundedDictionary[K, V, B](result), nil
+}
+
+func (d *BoundedDictionary[K, V, B]) Decode(reader io.Reader) error {
+	result, err := DecodeBoundedDictionary[K, V, B](reader)
+	if err != nil {
+		return err
+	}
+	*d = result
+	return nil
+}
+
+func decodeBoundedLength(reader io.Reader, bound int) (int, error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return 0, err
+	}
+	if size > bound {
+		return 0, errBoundExceeded
+	}
+	return size, nil
+}
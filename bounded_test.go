@@ -0,0 +1,201 @@
+package goscale
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type max2 struct{}
+
+func (max2) Bound() int { return 2 }
+
+func Test_BoundedSequence(t *testing.T) {
+	seq, err := NewBoundedSequence[U16, max2](1)
+	assert.NoError(t, err)
+
+	assert.NoError(t, seq.TryPush(2))
+	assert.Equal(t, errBoundExceeded, seq.TryPush(3))
+	assert.Equal(t, BoundedSequence[U16, max2]{1, 2}, seq)
+
+	_, err = NewBoundedSequence[U16, max2](1, 2, 3)
+	assert.Equal(t, errBoundExceeded, err)
+
+	var empty BoundedSequence[U16, max2]
+	assert.NoError(t, empty.TryPush(1))
+	assert.Equal(t, BoundedSequence[U16, max2]{1}, empty)
+}
+
+func Test_BoundedSequence_Encode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  BoundedSequence[U16, max2]
+		expect []byte
+		err    error
+	}{
+		{label: "Encode BoundedSequence[U16, max2]{}", input: BoundedSequence[U16, max2]{}, expect: []byte{0x00}},
+		{label: "Encode BoundedSequence[U16, max2]{1, 2}", input: BoundedSequence[U16, max2]{1, 2}, expect: []byte{0x08, 0x01, 0x00, 0x02, 0x00}},
+		{label: "Encode BoundedSequence[U16, max2]{1, 2, 3}", input: BoundedSequence[U16, max2]{1, 2, 3}, expect: []byte{}, err: errBoundExceeded},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.Equal(t, e.err, err)
+			assert.Equal(t, e.expect, append([]byte{}, buffer.Bytes()...))
+			if e.err == nil {
+				assert.Equal(t, len(e.expect), e.input.EncodedLen())
+			}
+		})
+	}
+}
+
+func Test_BoundedSequence_Decode(t *testing.T) {
+	result, err := DecodeBoundedSequence[U16, max2](bytes.NewBuffer([]byte{0x08, 0x01, 0x00, 0x02, 0x00}))
+	assert.NoError(t, err)
+	assert.Equal(t, BoundedSequence[U16, max2]{1, 2}, result)
+
+	// fails on the length prefix before reading the elements
+	buffer := bytes.NewBuffer([]byte{0x0c, 0x01, 0x00, 0x02, 0x00, 0x03, 0x00})
+	var seq BoundedSequence[U16, max2]
+	err = seq.Decode(buffer)
+	assert.Equal(t, errBoundExceeded, err)
+	assert.Equal(t, 6, buffer.Len())
+
+	_, err = DecodeBoundedSequence[U8, max2](bytes.NewBuffer([]byte{0x03, 0xff, 0xff, 0xff, 0xff}))
+	assert.Equal(t, errBoundExceeded, err)
+
+	nested, err := DecodeSequence[BoundedSequence[U8, max2]](bytes.NewBuffer([]byte{0x04, 0x04, 0x01}))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[BoundedSequence[U8, max2]]{{1}}, nested)
+}
+
+func Test_WeakBoundedSequence(t *testing.T) {
+	seq, err := NewWeakBoundedSequence[U8, max2](1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, errBoundExceeded, seq.TryPush(3))
+
+	_, err = NewWeakBoundedSequence[U8, max2](1, 2, 3)
+	assert.Equal(t, errBoundExceeded, err)
+
+	// the bound is not enforced when encoding and decoding
+	input := WeakBoundedSequence[U8, max2]{1, 2, 3}
+	assert.Equal(t, []byte{0x0c, 0x01, 0x02, 0x03}, input.Bytes())
+	assert.Equal(t, 4, input.EncodedLen())
+
+	var result WeakBoundedSequence[U8, max2]
+	err = result.Decode(bytes.NewBuffer([]byte{0x0c, 0x01, 0x02, 0x03}))
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	_, err = DecodeWeakBoundedSequence[U8, max2](bytes.NewBuffer([]byte{0x0c, 0x01}))
+	assert.Error(t, err)
+}
+
+func Test_BoundedDictionary(t *testing.T) {
+	d, err := NewBoundedDictionary[U8, Str, max2](map[U8]Str{1: "a"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, d.TryInsert(2, "b"))
+	// replacing a value does not grow the dictionary
+	assert.NoError(t, d.TryInsert(1, "c"))
+	assert.Equal(t, errBoundExceeded, d.TryInsert(3, "d"))
+	assert.Equal(t, BoundedDictionary[U8, Str, max2]{1: "c", 2: "b"}, d)
+
+	_, err = NewBoundedDictionary[U8, Str, max2](map[U8]Str{1: "a", 2: "b", 3: "c"})
+	assert.Equal(t, errBoundExceeded, err)
+
+	var empty BoundedDictionary[U8, Str, max2]
+	assert.NoError(t, empty.TryInsert(1, "a"))
+	assert.Equal(t, BoundedDictionary[U8, Str, max2]{1: "a"}, empty)
+}
+
+func Test_BoundedDictionary_Codec(t *testing.T) {
+	input := BoundedDictionary[U8, Str, max2]{2: "b", 1: "a"}
+	expect := []byte{0x08, 0x01, 0x04, 0x61, 0x02, 0x04, 0x62}
+
+	assert.Equal(t, expect, input.Bytes())
+	assert.Equal(t, len(expect), input.EncodedLen())
+
+	var result BoundedDictionary[U8, Str, max2]
+	err := result.Decode(bytes.NewBuffer(expect))
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	err = BoundedDictionary[U8, Str, max2]{1: "a", 2: "b", 3: "c"}.Encode(&bytes.Buffer{})
+	assert.Equal(t, errBoundExceeded, err)
+
+	_, err = DecodeBoundedDictionary[U8, Str, max2](bytes.NewBuffer([]byte{0x0c, 0x01, 0x04, 0x61}))
+	assert.Equal(t, errBoundExceeded, err)
+}
+
+func Test_Bounded_MaxEncodedLen(t *testing.T) {
+	max, ok := MaxEncodedLen[BoundedSequence[U32, max2]]()
+	assert.True(t, ok)
+	assert.Equal(t, 1+2*4, max)
+
+	max, ok = MaxEncodedLen[BoundedDictionary[U8, Option[U64], max2]]()
+	assert.True(t, ok)
+	assert.Equal(t, 1+2*(1+9), max)
+
+	_, ok = MaxEncodedLen[BoundedSequence[Str, max2]]()
+	assert.False(t, ok)
+
+	_, ok = MaxEncodedLen[WeakBoundedSequence[U32, max2]]()
+	assert.False(t, ok)
+}
+
+type TupleBoundedCollections struct {
+	Tuple
+	A BoundedSequence[TupleU8I8, max2]
+	B BoundedDictionary[U8, Bool, max2]
+	C BoundedSequence[U8, max2]
+}
+
+func Test_TupleBoundedCollections(t *testing.T) {
+	input := TupleBoundedCollections{
+		A: BoundedSequence[TupleU8I8, max2]{{B0: 1, B1: -1}},
+		B: BoundedDictionary[U8, Bool, max2]{1: true},
+		C: BoundedSequence[U8, max2]{7, 8},
+	}
+	expect := []byte{0x04, 0x01, 0xff, 0x04, 0x01, 0x01, 0x08, 0x07, 0x08}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	result := TupleBoundedCollections{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	var examples = []struct {
+		label string
+		input TupleBoundedCollections
+	}{
+		{label: "BoundedSequence of tuples", input: TupleBoundedCollections{A: BoundedSequence[TupleU8I8, max2]{{}, {}, {}}}},
+		{label: "BoundedDictionary", input: TupleBoundedCollections{B: BoundedDictionary[U8, Bool, max2]{1: true, 2: true, 3: true}}},
+		{label: "BoundedSequence[U8]", input: TupleBoundedCollections{C: BoundedSequence[U8, max2]{1, 2, 3}}},
+	}
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			err := EncodeTuple(e.input, &bytes.Buffer{})
+			assert.ErrorIs(t, err, errBoundExceeded)
+		})
+	}
+
+	var inputs = [][]byte{
+		{0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x00, 0x0c, 0x01, 0x01, 0x02, 0x01, 0x03, 0x01},
+		{0x00, 0x00, 0x0c, 0x01, 0x02, 0x03},
+	}
+	for _, input := range inputs {
+		err = DecodeTuple(&TupleBoundedCollections{}, bytes.NewBuffer(input))
+		assert.ErrorIs(t, err, errBoundExceeded)
+	}
+}
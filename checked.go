@@ -0,0 +1,584 @@
+package goscale
+
+/*
+	Checked arithmetic mirroring Rust's checked_* integer methods,
+	instead of None the functions return errOverflow, errUnderflow or errDivisionByZero.
+*/
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// CheckedInteger are the integer types supported by the checked arithmetic.
+type CheckedInteger interface {
+	U8 | U16 | U32 | U64 | U128 | U256 | I8 | I16 | I32 | I64 | I128 | I256
+}
+
+type unsignedInteger interface {
+	U8 | U16 | U32 | U64
+}
+
+type signedInteger interface {
+	I8 | I16 | I32 | I64
+}
+
+func CheckedAdd[T CheckedInteger](a, b T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedAddUnsigned(a, any(b).(U8)))
+	case U16:
+		return checkedResult[T](checkedAddUnsigned(a, any(b).(U16)))
+	case U32:
+		return checkedResult[T](checkedAddUnsigned(a, any(b).(U32)))
+	case U64:
+		return checkedResult[T](checkedAddUnsigned(a, any(b).(U64)))
+	case U128:
+		return checkedResult[T](checkedAddU128(a, any(b).(U128)))
+	case U256:
+		return checkedResult[T](CheckedAddU256(a, any(b).(U256)))
+	case I8:
+		return checkedResult[T](checkedAddSigned(a, any(b).(I8)))
+	case I16:
+		return checkedResult[T](checkedAddSigned(a, any(b).(I16)))
+	case I32:
+		return checkedResult[T](checkedAddSigned(a, any(b).(I32)))
+	case I64:
+		return checkedResult[T](checkedAddSigned(a, any(b).(I64)))
+	case I256:
+		return checkedResult[T](CheckedAddI256(a, any(b).(I256)))
+	default:
+		return checkedResult[T](checkedAddI128(any(a).(I128), any(b).(I128)))
+	}
+}
+
+func CheckedSub[T CheckedInteger](a, b T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedSubUnsigned(a, any(b).(U8)))
+	case U16:
+		return checkedResult[T](checkedSubUnsigned(a, any(b).(U16)))
+	case U32:
+		return checkedResult[T](checkedSubUnsigned(a, any(b).(U32)))
+	case U64:
+		return checkedResult[T](checkedSubUnsigned(a, any(b).(U64)))
+	case U128:
+		return checkedResult[T](checkedSubU128(a, any(b).(U128)))
+	case U256:
+		return checkedResult[T](CheckedSubU256(a, any(b).(U256)))
+	case I8:
+		return checkedResult[T](checkedSubSigned(a, any(b).(I8)))
+	case I16:
+		return checkedResult[T](checkedSubSigned(a, any(b).(I16)))
+	case I32:
+		return checkedResult[T](checkedSubSigned(a, any(b).(I32)))
+	case I64:
+		return checkedResult[T](checkedSubSigned(a, any(b).(I64)))
+	case I256:
+		return checkedResult[T](CheckedSubI256(a, any(b).(I256)))
+	default:
+		return checkedResult[T](checkedSubI128(any(a).(I128), any(b).(I128)))
+	}
+}
+
+func CheckedMul[T CheckedInteger](a, b T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedMulUnsigned(a, any(b).(U8)))
+	case U16:
+		return checkedResult[T](checkedMulUnsigned(a, any(b).(U16)))
+	case U32:
+		return checkedResult[T](checkedMulUnsigned(a, any(b).(U32)))
+	case U64:
+		return checkedResult[T](checkedMulUnsigned(a, any(b).(U64)))
+	case U128:
+		return checkedResult[T](checkedMulU128(a, any(b).(U128)))
+	case U256:
+		return checkedResult[T](CheckedMulU256(a, any(b).(U256)))
+	case I8:
+		return checkedResult[T](checkedMulSigned(a, any(b).(I8)))
+	case I16:
+		return checkedResult[T](checkedMulSigned(a, any(b).(I16)))
+	case I32:
+		return checkedResult[T](checkedMulSigned(a, any(b).(I32)))
+	case I64:
+		return checkedResult[T](checkedMulSigned(a, any(b).(I64)))
+	case I256:
+		return checkedResult[T](CheckedMulI256(a, any(b).(I256)))
+	default:
+		return checkedResult[T](checkedMulI128(any(a).(I128), any(b).(I128)))
+	}
+}
+
+// CheckedDiv truncates the quotient towards zero like Rust does.
+func CheckedDiv[T CheckedInteger](a, b T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedDivUnsigned(a, any(b).(U8)))
+	case U16:
+		return checkedResult[T](checkedDivUnsigned(a, any(b).(U16)))
+	case U32:
+		return checkedResult[T](checkedDivUnsigned(a, any(b).(U32)))
+	case U64:
+		return checkedResult[T](checkedDivUnsigned(a, any(b).(U64)))
+	case U128:
+		return checkedResult[T](checkedDivU128(a, any(b).(U128)))
+	case U256:
+		return checkedResult[T](checkedDivU256(a, any(b).(U256)))
+	case I8:
+		return checkedResult[T](checkedDivSigned(a, any(b).(I8)))
+	case I16:
+		return checkedResult[T](checkedDivSigned(a, any(b).(I16)))
+	case I32:
+		return checkedResult[T](checkedDivSigned(a, any(b).(I32)))
+	case I64:
+		return checkedResult[T](checkedDivSigned(a, any(b).(I64)))
+	case I256:
+		return checkedResult[T](checkedDivI256(a, any(b).(I256)))
+	default:
+		return checkedResult[T](checkedDivI128(any(a).(I128), any(b).(I128)))
+	}
+}
+
+// CheckedRem returns the remainder with the sign of the dividend like Rust does.
+func CheckedRem[T CheckedInteger](a, b T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedRemUnsigned(a, any(b).(U8)))
+	case U16:
+		return checkedResult[T](checkedRemUnsigned(a, any(b).(U16)))
+	case U32:
+		return checkedResult[T](checkedRemUnsigned(a, any(b).(U32)))
+	case U64:
+		return checkedResult[T](checkedRemUnsigned(a, any(b).(U64)))
+	case U128:
+		return checkedResult[T](checkedRemU128(a, any(b).(U128)))
+	case U256:
+		return checkedResult[T](checkedRemU256(a, any(b).(U256)))
+	case I8:
+		return checkedResult[T](checkedRemSigned(a, any(b).(I8)))
+	case I16:
+		return checkedResult[T](checkedRemSigned(a, any(b).(I16)))
+	case I32:
+		return checkedResult[T](checkedRemSigned(a, any(b).(I32)))
+	case I64:
+		return checkedResult[T](checkedRemSigned(a, any(b).(I64)))
+	case I256:
+		return checkedResult[T](checkedRemI256(a, any(b).(I256)))
+	default:
+		return checkedResult[T](checkedRemI128(any(a).(I128), any(b).(I128)))
+	}
+}
+
+// CheckedNeg fails for the unsigned values other than zero and for the minimum signed values.
+func CheckedNeg[T CheckedInteger](a T) (T, error) {
+	switch a := any(a).(type) {
+	case U8:
+		return checkedResult[T](checkedNegUnsigned(a))
+	case U16:
+		return checkedResult[T](checkedNegUnsigned(a))
+	case U32:
+		return checkedResult[T](checkedNegUnsigned(a))
+	case U64:
+		return checkedResult[T](checkedNegUnsigned(a))
+	case U128:
+		if a != (U128{}) {
+			return *new(T), errUnderflow
+		}
+		return checkedResult[T](a, nil)
+	case U256:
+		if a != (U256{}) {
+			return *new(T), errUnderflow
+		}
+		return checkedResult[T](a, nil)
+	case I8:
+		return checkedResult[T](checkedNegSigned(a))
+	case I16:
+		return checkedResult[T](checkedNegSigned(a))
+	case I32:
+		return checkedResult[T](checkedNegSigned(a))
+	case I64:
+		return checkedResult[T](checkedNegSigned(a))
+	case I256:
+		return checkedResult[T](checkedNegI256(a))
+	default:
+		return checkedResult[T](checkedNegI128(any(a).(I128)))
+	}
+}
+
+// CheckedPow raises base to the power of exp by squaring.
+func CheckedPow[T CheckedInteger](base T, exp uint32) (T, error) {
+	result, err := checkedOne[T](), error(nil)
+	value := base
+	for n := exp; n > 0 && err == nil; {
+		if n&1 == 1 {
+			result, err = CheckedMul(result, value)
+		}
+		n >>= 1
+		if n > 0 && err == nil {
+			value, err = CheckedMul(value, value)
+		}
+	}
+	if err != nil {
+		// the squares overflow even if the power is negative,
+		// whose sign follows the base and the parity of the exponent
+		if exp&1 == 1 && checkedIsNegative(base) {
+			return *new(T), errUnderflow
+		}
+		return *new(T), errOverflow
+	}
+	return result, nil
+}
+
+// checkedResult converts the result of a concrete type back to T.
+func checkedResult[T CheckedInteger](value any, err error) (T, error) {
+	if err != nil {
+		return *new(T), err
+	}
+	return value.(T), nil
+}
+
+func checkedIsNegative[T CheckedInteger](value T) bool {
+	switch v := any(value).(type) {
+	case I8:
+		return v < 0
+	case I16:
+		return v < 0
+	case I32:
+		return v < 0
+	case I64:
+		return v < 0
+	case I128:
+		return v.isNegative()
+	case I256:
+		return v.isNegative()
+	default:
+		return false
+	}
+}
+
+func checkedOne[T CheckedInteger]() T {
+	var one T
+	switch v := any(&one).(type) {
+	case *U8:
+		*v = 1
+	case *U16:
+		*v = 1
+	case *U32:
+		*v = 1
+	case *U64:
+		*v = 1
+	case *U128:
+		*v = U128{1}
+	case *U256:
+		*v = U256{1}
+	case *I8:
+		*v = 1
+	case *I16:
+		*v = 1
+	case *I32:
+		*v = 1
+	case *I64:
+		*v = 1
+	case *I128:
+		*v = I128{1}
+	case *I256:
+		*v = I256{1}
+	}
+	return one
+}
+
+func checkedAddUnsigned[T unsignedInteger](a, b T) (T, error) {
+	sum := a + b
+	if sum < a {
+		return 0, errOverflow
+	}
+	return sum, nil
+}
+
+func checkedSubUnsigned[T unsignedInteger](a, b T) (T, error) {
+	if b > a {
+		return 0, errUnderflow
+	}
+	return a - b, nil
+}
+
+func checkedMulUnsigned[T unsignedInteger](a, b T) (T, error) {
+	if a == 0 {
+		return 0, nil
+	}
+	product := a * b
+	if product/a != b {
+		return 0, errOverflow
+	}
+	return product, nil
+}
+
+func checkedDivUnsigned[T unsignedInteger](a, b T) (T, error) {
+	if b == 0 {
+		return 0, errDivisionByZero
+	}
+	return a / b, nil
+}
+
+func checkedRemUnsigned[T unsignedInteger](a, b T) (T, error) {
+	if b == 0 {
+		return 0, errDivisionByZero
+	}
+	return a % b, nil
+}
+
+func checkedNegUnsigned[T unsignedInteger](a T) (T, error) {
+	if a != 0 {
+		return 0, errUnderflow
+	}
+	return 0, nil
+}
+
+// isMinSigned reports whether a is the minimum value of its type,
+// which is the only value besides zero that is its own negation.
+func isMinSigned[T signedInteger](a T) bool {
+	return a != 0 && a == -a
+}
+
+func checkedAddSigned[T signedInteger](a, b T) (T, error) {
+	sum := a + b
+	if b > 0 && sum < a {
+		return 0, errOverflow
+	}
+	if b < 0 && sum > a {
+		return 0, errUnderflow
+	}
+	return sum, nil
+}
+
+func checkedSubSigned[T signedInteger](a, b T) (T, error) {
+	diff := a - b
+	if b < 0 && diff < a {
+		return 0, errOverflow
+	}
+	if b > 0 && diff > a {
+		return 0, errUnderflow
+	}
+	return diff, nil
+}
+
+func checkedMulSigned[T signedInteger](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	if a == -1 {
+		return checkedNegSigned(b)
+	}
+	if b == -1 {
+		return checkedNegSigned(a)
+	}
+	product := a * b
+	if product/b != a {
+		if (a < 0) == (b < 0) {
+			return 0, errOverflow
+		}
+		return 0, errUnderflow
+	}
+	return product, nil
+}
+
+func checkedDivSigned[T signedInteger](a, b T) (T, error) {
+	if b == 0 {
+		return 0, errDivisionByZero
+	}
+	if b == -1 && isMinSigned(a) {
+		return 0, errOverflow
+	}
+	return a / b, nil
+}
+
+func checkedRemSigned[T signedInteger](a, b T) (T, error) {
+	if b == 0 {
+		return 0, errDivisionByZero
+	}
+	if b == -1 && isMinSigned(a) {
+		return 0, errOverflow
+	}
+	return a % b, nil
+}
+
+func checkedNegSigned[T signedInteger](a T) (T, error) {
+	if isMinSigned(a) {
+		return 0, errOverflow
+	}
+	return -a, nil
+}
+
+func checkedAddU128(a, b U128) (U128, error) {
+	sumLow, carry := bits.Add64(uint64(a[0]), uint64(b[0]), 0)
+	sumHigh, carry := bits.Add64(uint64(a[1]), uint64(b[1]), carry)
+	if carry != 0 {
+		return U128{}, errOverflow
+	}
+	return U128{U64(sumLow), U64(sumHigh)}, nil
+}
+
+func checkedSubU128(a, b U128) (U128, error) {
+	diffLow, borrow := bits.Sub64(uint64(a[0]), uint64(b[0]), 0)
+	diffHigh, borrow := bits.Sub64(uint64(a[1]), uint64(b[1]), borrow)
+	if borrow != 0 {
+		return U128{}, errUnderflow
+	}
+	return U128{U64(diffLow), U64(diffHigh)}, nil
+}
+
+func checkedMulU128(a, b U128) (U128, error) {
+	low, _ := mul256([4]U64{a[0], a[1]}, [4]U64{b[0], b[1]})
+	if low[2] != 0 || low[3] != 0 {
+		return U128{}, errOverflow
+	}
+	return U128{low[0], low[1]}, nil
+}
+
+func checkedDivU128(a, b U128) (U128, error) {
+	if b == (U128{}) {
+		return U128{}, errDivisionByZero
+	}
+	return a.Div(b), nil
+}
+
+func checkedRemU128(a, b U128) (U128, error) {
+	if b == (U128{}) {
+		return U128{}, errDivisionByZero
+	}
+	return a.Mod(b), nil
+}
+
+func checkedAddI128(a, b I128) (I128, error) {
+	sum := a.Add(b)
+	// the sum of two operands with the same sign can not change it
+	if a.isNegative() == b.isNegative() && sum.isNegative() != a.isNegative() {
+		if a.isNegative() {
+			return I128{}, errUnderflow
+		}
+		return I128{}, errOverflow
+	}
+	return sum, nil
+}
+
+func checkedSubI128(a, b I128) (I128, error) {
+	diff := a.Sub(b)
+	// the difference of two operands with different signs has the sign of the minuend
+	if a.isNegative() != b.isNegative() && diff.isNegative() != a.isNegative() {
+		if a.isNegative() {
+			return I128{}, errUnderflow
+		}
+		return I128{}, errOverflow
+	}
+	return diff, nil
+}
+
+func checkedMulI128(a, b I128) (I128, error) {
+	negative := a.isNegative() != b.isNegative()
+	product, err := checkedMulU128(U128(absI128(a)), U128(absI128(b)))
+	// the magnitude of a negative product can reach 2^127, a positive one 2^127-1
+	if err != nil || product[1] > 1<<63 || (product[1] == 1<<63 && (product[0] != 0 || !negative)) {
+		if negative {
+			return I128{}, errUnderflow
+		}
+		return I128{}, errOverflow
+	}
+	if negative {
+		return negateI128(I128(product)), nil
+	}
+	return I128(product), nil
+}
+
+func checkedDivI128(a, b I128) (I128, error) {
+	if b == (I128{}) {
+		return I128{}, errDivisionByZero
+	}
+	if a == MinI128() && b == NewI128(-1) {
+		return I128{}, errOverflow
+	}
+	quotient, _ := quoRemI128(a, b)
+	return quotient, nil
+}
+
+func checkedRemI128(a, b I128) (I128, error) {
+	if b == (I128{}) {
+		return I128{}, errDivisionByZero
+	}
+	if a == MinI128() && b == NewI128(-1) {
+		return I128{}, errOverflow
+	}
+	_, remainder := quoRemI128(a, b)
+	return remainder, nil
+}
+
+func checkedNegI128(a I128) (I128, error) {
+	if a == MinI128() {
+		return I128{}, errOverflow
+	}
+	return negateI128(a), nil
+}
+
+// absI128 returns the magnitude, which is MinI128 for MinI128 and must be read as unsigned.
+func absI128(n I128) I128 {
+	if n.isNegative() {
+		return negateI128(n)
+	}
+	return n
+}
+
+// quoRemI128 implements the truncated division of Go and Rust,
+// the remainder has the sign of the dividend.
+func quoRemI128(a, b I128) (I128, I128) {
+	q, r := divMod128(absI128(a), absI128(b))
+	quotient, remainder := I128(q), I128(r)
+	if a.isNegative() != b.isNegative() {
+		quotient = negateI128(quotient)
+	}
+	if a.isNegative() {
+		remainder = negateI128(remainder)
+	}
+	return quotient, remainder
+}
+
+func checkedDivU256(a, b U256) (U256, error) {
+	if b == (U256{}) {
+		return U256{}, errDivisionByZero
+	}
+	return a.Div(b), nil
+}
+
+func checkedRemU256(a, b U256) (U256, error) {
+	if b == (U256{}) {
+		return U256{}, errDivisionByZero
+	}
+	return a.Mod(b), nil
+}
+
+// checkedDivI256 truncates with big.Int Quo, I256.Div rounds like big.Int Div instead.
+func checkedDivI256(a, b I256) (I256, error) {
+	if b == (I256{}) {
+		return I256{}, errDivisionByZero
+	}
+	if a == MinI256() && b == NewI256(-1) {
+		return I256{}, errOverflow
+	}
+	return bigIntToI256(new(big.Int).Quo(a.ToBigInt(), b.ToBigInt())), nil
+}
+
+func checkedRemI256(a, b I256) (I256, error) {
+	if b == (I256{}) {
+		return I256{}, errDivisionByZero
+	}
+	if a == MinI256() && b == NewI256(-1) {
+		return I256{}, errOverflow
+	}
+	return bigIntToI256(new(big.Int).Rem(a.ToBigInt(), b.ToBigInt())), nil
+}
+
+func checkedNegI256(a I256) (I256, error) {
+	if a == MinI256() {
+		return I256{}, errOverflow
+	}
+	return negateI256(a), nil
+}
@@ -0,0 +1,407 @@
+package goscale
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// expectChecked returns the result of the exact operation or the error for the range of [min, max].
+func expectChecked(exact *big.Int, min, max int64) (int64, error) {
+	if exact.Cmp(big.NewInt(max)) > 0 {
+		return 0, errOverflow
+	}
+	if exact.Cmp(big.NewInt(min)) < 0 {
+		return 0, errUnderflow
+	}
+	return exact.Int64(), nil
+}
+
+func Test_Checked_I8_Exhaustive(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			x, y := I8(a), I8(b)
+
+			expect, expectErr := expectChecked(big.NewInt(int64(a+b)), math.MinInt8, math.MaxInt8)
+			result, err := CheckedAdd(x, y)
+			assert.Equal(t, expectErr, err, "%d + %d", a, b)
+			assert.Equal(t, I8(expect), result, "%d + %d", a, b)
+
+			expect, expectErr = expectChecked(big.NewInt(int64(a-b)), math.MinInt8, math.MaxInt8)
+			result, err = CheckedSub(x, y)
+			assert.Equal(t, expectErr, err, "%d - %d", a, b)
+			assert.Equal(t, I8(expect), result, "%d - %d", a, b)
+
+			expect, expectErr = expectChecked(big.NewInt(int64(a*b)), math.MinInt8, math.MaxInt8)
+			result, err = CheckedMul(x, y)
+			assert.Equal(t, expectErr, err, "%d * %d", a, b)
+			assert.Equal(t, I8(expect), result, "%d * %d", a, b)
+
+			if b == 0 {
+				_, err = CheckedDiv(x, y)
+				assert.Equal(t, errDivisionByZero, err)
+				_, err = CheckedRem(x, y)
+				assert.Equal(t, errDivisionByZero, err)
+				continue
+			}
+
+			expect, expectErr = expectChecked(big.NewInt(int64(a/b)), math.MinInt8, math.MaxInt8)
+			result, err = CheckedDiv(x, y)
+			assert.Equal(t, expectErr, err, "%d / %d", a, b)
+			assert.Equal(t, I8(expect), result, "%d / %d", a, b)
+
+			result, err = CheckedRem(x, y)
+			if expectErr != nil {
+				assert.Equal(t, errOverflow, err, "%d %% %d", a, b)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, I8(a%b), result, "%d %% %d", a, b)
+			}
+		}
+	}
+}
+
+func Test_Checked_U8_Exhaustive(t *testing.T) {
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			x, y := U8(a), U8(b)
+
+			expect, expectErr := expectChecked(big.NewInt(int64(a+b)), 0, math.MaxUint8)
+			result, err := CheckedAdd(x, y)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, U8(expect), result)
+
+			expect, expectErr = expectChecked(big.NewInt(int64(a-b)), 0, math.MaxUint8)
+			result, err = CheckedSub(x, y)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, U8(expect), result)
+
+			expect, expectErr = expectChecked(big.NewInt(int64(a*b)), 0, math.MaxUint8)
+			result, err = CheckedMul(x, y)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, U8(expect), result)
+
+			if b == 0 {
+				_, err = CheckedDiv(x, y)
+				assert.Equal(t, errDivisionByZero, err)
+				_, err = CheckedRem(x, y)
+				assert.Equal(t, errDivisionByZero, err)
+				continue
+			}
+
+			result, err = CheckedDiv(x, y)
+			assert.NoError(t, err)
+			assert.Equal(t, U8(a/b), result)
+
+			result, err = CheckedRem(x, y)
+			assert.NoError(t, err)
+			assert.Equal(t, U8(a%b), result)
+		}
+	}
+}
+
+func Test_Checked_Widths(t *testing.T) {
+	_, err := CheckedAdd(U16(math.MaxUint16), 1)
+	assert.Equal(t, errOverflow, err)
+	_, err = CheckedSub(U32(0), 1)
+	assert.Equal(t, errUnderflow, err)
+	_, err = CheckedMul(U64(math.MaxUint32+1), math.MaxUint32+1)
+	assert.Equal(t, errOverflow, err)
+	_, err = CheckedAdd(I16(math.MinInt16), -1)
+	assert.Equal(t, errUnderflow, err)
+	_, err = CheckedMul(I32(math.MinInt32), 2)
+	assert.Equal(t, errUnderflow, err)
+	_, err = CheckedDiv(I64(math.MinInt64), -1)
+	assert.Equal(t, errOverflow, err)
+
+	result, err := CheckedMul(I64(math.MinInt32), math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, I64(math.MinInt32*math.MaxInt32), result)
+}
+
+func Test_Checked_128_MatchesBigInt(t *testing.T) {
+	minI128, maxI128 := MinI128().ToBigInt(), MaxI128().ToBigInt()
+	maxU128 := MaxU128().ToBigInt()
+
+	expectU128 := func(exact *big.Int) (U128, error) {
+		if exact.Sign() < 0 {
+			return U128{}, errUnderflow
+		}
+		if exact.Cmp(maxU128) > 0 {
+			return U128{}, errOverflow
+		}
+		return bigIntToU128(exact), nil
+	}
+	expectI128 := func(exact *big.Int) (I128, error) {
+		if exact.Cmp(minI128) < 0 {
+			return I128{}, errUnderflow
+		}
+		if exact.Cmp(maxI128) > 0 {
+			return I128{}, errOverflow
+		}
+		return bigIntToI128(exact), nil
+	}
+
+	operands := u128Operands()
+	for _, a := range operands {
+		for _, b := range operands {
+			x, y := a.ToBigInt(), b.ToBigInt()
+
+			expect, expectErr := expectU128(new(big.Int).Add(x, y))
+			result, err := CheckedAdd(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			expect, expectErr = expectU128(new(big.Int).Sub(x, y))
+			result, err = CheckedSub(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			expect, expectErr = expectU128(new(big.Int).Mul(x, y))
+			result, err = CheckedMul(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			if b != (U128{}) {
+				result, err = CheckedDiv(a, b)
+				assert.NoError(t, err)
+				assert.Equal(t, bigIntToU128(new(big.Int).Quo(x, y)), result)
+
+				result, err = CheckedRem(a, b)
+				assert.NoError(t, err)
+				assert.Equal(t, bigIntToU128(new(big.Int).Rem(x, y)), result)
+			}
+
+			sa, sb := I128(a), I128(b)
+			x, y = sa.ToBigInt(), sb.ToBigInt()
+
+			expectSigned, expectErr := expectI128(new(big.Int).Add(x, y))
+			resultSigned, err := CheckedAdd(sa, sb)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			expectSigned, expectErr = expectI128(new(big.Int).Sub(x, y))
+			resultSigned, err = CheckedSub(sa, sb)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			expectSigned, expectErr = expectI128(new(big.Int).Mul(x, y))
+			resultSigned, err = CheckedMul(sa, sb)
+			assert.Equal(t, expectErr, err, "%v * %v", x, y)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			if sb != (I128{}) {
+				expectSigned, expectErr = expectI128(new(big.Int).Quo(x, y))
+				resultSigned, err = CheckedDiv(sa, sb)
+				assert.Equal(t, expectErr, err)
+				assert.Equal(t, expectSigned, resultSigned)
+
+				resultSigned, err = CheckedRem(sa, sb)
+				if expectErr == nil {
+					assert.NoError(t, err)
+					assert.Equal(t, bigIntToI128(new(big.Int).Rem(x, y)), resultSigned)
+				} else {
+					assert.Equal(t, errOverflow, err)
+				}
+			}
+		}
+	}
+}
+
+func u256Operands() []U256 {
+	operands := []U256{
+		{}, {1}, {2}, {math.MaxUint64}, {0, 1}, {0, 0, 1}, {0, 0, 0, 1},
+		{math.MaxUint64, math.MaxUint64}, {0, 0, 0, 1 << 63}, {0, 0, 0, 1 << 62},
+		MaxU256(), U256(MaxI256()), U256(NewI256(-1)), U256(NewI256(-2)),
+	}
+
+	random := rand.New(rand.NewSource(42))
+	for i := 0; i < 12; i++ {
+		operands = append(operands,
+			U256{U64(random.Uint64()), U64(random.Uint64()), U64(random.Uint64()), U64(random.Uint64())},
+			U256{U64(random.Uint64()), U64(random.Uint64() >> random.Intn(64))},
+		)
+	}
+	return operands
+}
+
+func Test_Checked_256_MatchesBigInt(t *testing.T) {
+	minI256, maxI256 := MinI256().ToBigInt(), MaxI256().ToBigInt()
+	maxU256 := MaxU256().ToBigInt()
+
+	expectU256 := func(exact *big.Int) (U256, error) {
+		if exact.Sign() < 0 {
+			return U256{}, errUnderflow
+		}
+		if exact.Cmp(maxU256) > 0 {
+			return U256{}, errOverflow
+		}
+		return bigIntToU256(exact), nil
+	}
+	expectI256 := func(exact *big.Int) (I256, error) {
+		if exact.Cmp(minI256) < 0 {
+			return I256{}, errUnderflow
+		}
+		if exact.Cmp(maxI256) > 0 {
+			return I256{}, errOverflow
+		}
+		return bigIntToI256(exact), nil
+	}
+
+	operands := u256Operands()
+	for _, a := range operands {
+		for _, b := range operands {
+			x, y := a.ToBigInt(), b.ToBigInt()
+
+			expect, expectErr := expectU256(new(big.Int).Add(x, y))
+			result, err := CheckedAdd(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			expect, expectErr = expectU256(new(big.Int).Sub(x, y))
+			result, err = CheckedSub(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			expect, expectErr = expectU256(new(big.Int).Mul(x, y))
+			result, err = CheckedMul(a, b)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expect, result)
+
+			if b != (U256{}) {
+				result, err = CheckedDiv(a, b)
+				assert.NoError(t, err)
+				assert.Equal(t, bigIntToU256(new(big.Int).Quo(x, y)), result)
+
+				result, err = CheckedRem(a, b)
+				assert.NoError(t, err)
+				assert.Equal(t, bigIntToU256(new(big.Int).Rem(x, y)), result)
+			}
+
+			sa, sb := I256(a), I256(b)
+			x, y = sa.ToBigInt(), sb.ToBigInt()
+
+			expectSigned, expectErr := expectI256(new(big.Int).Add(x, y))
+			resultSigned, err := CheckedAdd(sa, sb)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			expectSigned, expectErr = expectI256(new(big.Int).Sub(x, y))
+			resultSigned, err = CheckedSub(sa, sb)
+			assert.Equal(t, expectErr, err)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			expectSigned, expectErr = expectI256(new(big.Int).Mul(x, y))
+			resultSigned, err = CheckedMul(sa, sb)
+			assert.Equal(t, expectErr, err, "%v * %v", x, y)
+			assert.Equal(t, expectSigned, resultSigned)
+
+			if sb != (I256{}) {
+				expectSigned, expectErr = expectI256(new(big.Int).Quo(x, y))
+				resultSigned, err = CheckedDiv(sa, sb)
+				assert.Equal(t, expectErr, err)
+				assert.Equal(t, expectSigned, resultSigned)
+
+				resultSigned, err = CheckedRem(sa, sb)
+				if expectErr == nil {
+					assert.NoError(t, err)
+					assert.Equal(t, bigIntToI256(new(big.Int).Rem(x, y)), resultSigned)
+				} else {
+					assert.Equal(t, errOverflow, err)
+				}
+			}
+		}
+	}
+}
+
+func Test_CheckedDiv_128_ByZero(t *testing.T) {
+	_, err := CheckedDiv(NewU128(1), NewU128(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedRem(NewU128(1), NewU128(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedDiv(NewI128(1), NewI128(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedRem(NewI128(1), NewI128(0))
+	assert.Equal(t, errDivisionByZero, err)
+}
+
+func Test_CheckedDiv_256_ByZero(t *testing.T) {
+	_, err := CheckedDiv(NewU256(1), NewU256(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedRem(NewU256(1), NewU256(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedDiv(NewI256(1), NewI256(0))
+	assert.Equal(t, errDivisionByZero, err)
+	_, err = CheckedRem(NewI256(1), NewI256(0))
+	assert.Equal(t, errDivisionByZero, err)
+}
+
+func Test_CheckedNeg(t *testing.T) {
+	testExamples := []struct {
+		label     string
+		operation func() (any, error)
+		expect    any
+		expectErr error
+	}{
+		{"U8(0)", func() (any, error) { return CheckedNeg(U8(0)) }, U8(0), nil},
+		{"U64(1)", func() (any, error) { return CheckedNeg(U64(1)) }, U64(0), errUnderflow},
+		{"U128(0)", func() (any, error) { return CheckedNeg(NewU128(0)) }, NewU128(0), nil},
+		{"U128(1)", func() (any, error) { return CheckedNeg(NewU128(1)) }, U128{}, errUnderflow},
+		{"I8(-128)", func() (any, error) { return CheckedNeg(I8(math.MinInt8)) }, I8(0), errOverflow},
+		{"I32(5)", func() (any, error) { return CheckedNeg(I32(5)) }, I32(-5), nil},
+		{"I128(-5)", func() (any, error) { return CheckedNeg(NewI128(-5)) }, NewI128(5), nil},
+		{"MinI128", func() (any, error) { return CheckedNeg(MinI128()) }, I128{}, errOverflow},
+		{"U256(0)", func() (any, error) { return CheckedNeg(NewU256(0)) }, NewU256(0), nil},
+		{"U256(1)", func() (any, error) { return CheckedNeg(NewU256(1)) }, U256{}, errUnderflow},
+		{"I256(-5)", func() (any, error) { return CheckedNeg(NewI256(-5)) }, NewI256(5), nil},
+		{"MaxI256", func() (any, error) { return CheckedNeg(MaxI256()) }, MinI256().Add(NewI256(1)), nil},
+		{"MinI256", func() (any, error) { return CheckedNeg(MinI256()) }, I256{}, errOverflow},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := testExample.operation()
+
+			assert.Equal(t, testExample.expect, result)
+			assert.Equal(t, testExample.expectErr, err)
+		})
+	}
+}
+
+func Test_CheckedPow(t *testing.T) {
+	testExamples := []struct {
+		label     string
+		operation func() (any, error)
+		expect    any
+		expectErr error
+	}{
+		{"U8(2)^7", func() (any, error) { return CheckedPow(U8(2), 7) }, U8(128), nil},
+		{"U8(2)^8", func() (any, error) { return CheckedPow(U8(2), 8) }, U8(0), errOverflow},
+		{"U32(0)^0", func() (any, error) { return CheckedPow(U32(0), 0) }, U32(1), nil},
+		{"I8(-2)^7", func() (any, error) { return CheckedPow(I8(-2), 7) }, I8(math.MinInt8), nil},
+		{"I8(-2)^8", func() (any, error) { return CheckedPow(I8(-2), 8) }, I8(0), errOverflow},
+		{"I8(-16)^3", func() (any, error) { return CheckedPow(I8(-16), 3) }, I8(0), errUnderflow},
+		{"I64(-3)^39", func() (any, error) { return CheckedPow(I64(-3), 39) }, I64(-4052555153018976267), nil},
+		{"U128(10)^38", func() (any, error) { return CheckedPow(NewU128(10), 38) }, bigIntToU128(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil)), nil},
+		{"U128(10)^39", func() (any, error) { return CheckedPow(NewU128(10), 39) }, U128{}, errOverflow},
+		{"I128(-2)^127", func() (any, error) { return CheckedPow(NewI128(-2), 127) }, MinI128(), nil},
+		{"I128(2)^127", func() (any, error) { return CheckedPow(NewI128(2), 127) }, I128{}, errOverflow},
+		{"U256(10)^77", func() (any, error) { return CheckedPow(NewU256(10), 77) }, bigIntToU256(new(big.Int).Exp(big.NewInt(10), big.NewInt(77), nil)), nil},
+		{"U256(10)^78", func() (any, error) { return CheckedPow(NewU256(10), 78) }, U256{}, errOverflow},
+		{"I256(-2)^255", func() (any, error) { return CheckedPow(NewI256(-2), 255) }, MinI256(), nil},
+		{"I256(2)^255", func() (any, error) { return CheckedPow(NewI256(2), 255) }, I256{}, errOverflow},
+		{"I256(-3)^161", func() (any, error) { return CheckedPow(NewI256(-3), 161) }, I256{}, errUnderflow},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := testExample.operation()
+
+			assert.Equal(t, testExample.expect, result)
+			assert.Equal(t, testExample.expectErr, err)
+		})
+	}
+}
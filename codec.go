@@ -19,6 +19,68 @@ type Encoder struct {
 
 type Decoder struct {
 	Reader io.Reader
+	// Type names the value being decoded, it is reported when the input is truncated.
+	Type string
+}
+
+// DecodeError reports input that ends in the middle of a value.
+// Offset is the number of bytes consumed when the input ended, or -1 if
+// the reader does not count them (see CountingReader). Read and Expected
+// are zero when the input ends between the nested values of a collection,
+// Option, Result, enum or tuple.
+type DecodeError struct {
+	Type     string
+	Offset   int64
+	Read     int
+	Expected int
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	msg := "can not decode " + e.Type
+	if e.Offset >= 0 {
+		msg += " at offset " + strconv.FormatInt(e.Offset, 10)
+	}
+	msg += ": " + e.Err.Error()
+	if e.Expected == 0 {
+		return msg
+	}
+	return msg + ", read " + strconv.Itoa(e.Read) + " of " + strconv.Itoa(e.Expected) + " bytes"
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// CountingReader counts the bytes consumed from the underlying reader,
+// decoding errors report their offsets when reading from it.
+type CountingReader struct {
+	Reader   io.Reader
+	consumed int64
+}
+
+func NewCountingReader(reader io.Reader) *CountingReader {
+	return &CountingReader{Reader: reader}
+}
+
+func (r *CountingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.consumed += int64(n)
+	return n, err
+}
+
+func (r *CountingReader) ReadByte() (byte, error) {
+	b, err := Decoder{Reader: r.Reader}.DecodeByte()
+	if err != nil {
+		return 0, err
+	}
+	r.consumed++
+	return b, nil
+}
+
+// Consumed returns the number of bytes read so far.
+func (r *CountingReader) Consumed() int64 {
+	return r.consumed
 }
 
 func (enc Encoder) Write(bytes []byte) error {
@@ -32,24 +94,81 @@ func (enc Encoder) Write(bytes []byte) error {
 	return nil
 }
 
-func (dec Decoder) Read(bytes []byte) error {
-	n, err := dec.Reader.Read(bytes)
+// WriteString avoids copying s into a []byte for the writers implementing io.StringWriter.
+func (enc Encoder) WriteString(s string) error {
+	n, err := io.WriteString(enc.Writer, s)
 	if err != nil {
 		return err
 	}
-	if n < len(bytes) {
-		return errors.New("can not read the required number of bytes " + strconv.Itoa(len(bytes)) + ", only " + strconv.Itoa(n) + " available")
+	if n < len(s) {
+		return errors.New("can not write the provided " + strconv.Itoa(len(s)) + " bytes to writer")
 	}
 	return nil
 }
 
+// Read fills bytes completely, reading as many times as the reader requires.
+// It returns io.EOF if no bytes were available and a *DecodeError wrapping
+// io.ErrUnexpectedEOF if the input ends before bytes is filled.
+func (dec Decoder) Read(bytes []byte) error {
+	n, err := io.ReadFull(dec.Reader, bytes)
+	if err == io.ErrUnexpectedEOF {
+		return dec.newDecodeError(n, len(bytes))
+	}
+	return err
+}
+
+// ReadTail reads the rest of a value whose first consumed bytes are already read,
+// the truncated input is reported with the bytes read and expected of the whole value.
+func (dec Decoder) ReadTail(bytes []byte, consumed int) error {
+	n, err := io.ReadFull(dec.Reader, bytes)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return dec.newDecodeError(consumed+n, consumed+len(bytes))
+	}
+	return err
+}
+
+// Truncated reports a clean io.EOF of a nested value as truncated input, used when
+// the enclosing value has already consumed some of the input.
+func (dec Decoder) Truncated(err error) error {
+	if err == io.EOF {
+		return dec.newDecodeError(0, 0)
+	}
+
+	// the tuples report the path of the field that ended the input
+	var fieldErr *TupleFieldError
+	if errors.As(err, &fieldErr) && fieldErr.Err == io.EOF {
+		fieldErr.Err = dec.newDecodeError(0, 0)
+	}
+	return err
+}
+
+func (dec Decoder) newDecodeError(read, expected int) error {
+	offset := int64(-1)
+	if counter, ok := dec.Reader.(interface{ Consumed() int64 }); ok {
+		offset = counter.Consumed()
+	}
+	return &DecodeError{
+		Type:     dec.Type,
+		Offset:   offset,
+		Read:     read,
+		Expected: expected,
+		Err:      io.ErrUnexpectedEOF,
+	}
+}
+
 func (enc Encoder) EncodeByte(b byte) error {
+	if byteWriter, ok := enc.Writer.(io.ByteWriter); ok {
+		return byteWriter.WriteByte(b)
+	}
 	buf := make([]byte, 1)
 	buf[0] = b
 	return enc.Write(buf[:1])
 }
 
 func (dec Decoder) DecodeByte() (byte, error) {
+	if byteReader, ok := dec.Reader.(io.ByteReader); ok {
+		return byteReader.ReadByte()
+	}
 	buf := make([]byte, 1)
 	err := dec.Read(buf[:1])
 	if err != nil {
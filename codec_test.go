@@ -0,0 +1,215 @@
+package goscale
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hides the io.ByteReader/io.ByteWriter fast paths of the wrapped value
+type plainReader struct {
+	reader *bytes.Reader
+}
+
+func (r plainReader) Read(p []byte) (int, error) {
+	return r.reader.Read(p)
+}
+
+type plainWriter struct {
+	buffer *bytes.Buffer
+}
+
+func (w plainWriter) Write(p []byte) (int, error) {
+	return w.buffer.Write(p)
+}
+
+func Test_Encoder_EncodeByte(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := Encoder{Writer: buffer}.EncodeByte(0x2a)
+	assert.NoError(t, err)
+
+	err = Encoder{Writer: plainWriter{buffer}}.EncodeByte(0x2b)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []byte{0x2a, 0x2b}, buffer.Bytes())
+}
+
+func Test_Decoder_DecodeByte(t *testing.T) {
+	reader := bytes.NewReader([]byte{0x2a, 0x2b})
+
+	b, err := Decoder{Reader: reader}.DecodeByte()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x2a), b)
+
+	b, err = Decoder{Reader: plainReader{reader}}.DecodeByte()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x2b), b)
+}
+
+func Test_Streaming_RoundTrip(t *testing.T) {
+	input := Dictionary[Str, Sequence[Option[U128]]]{
+		"abc": {{true, NewU128(1)}, {false, U128{}}},
+		"xyz": {},
+	}
+	buffer := &bytes.Buffer{}
+
+	err := input.Encode(plainWriter{buffer})
+	assert.NoError(t, err)
+	assert.Equal(t, input.Bytes(), buffer.Bytes())
+
+	result, err := DecodeDictionary[Str, Sequence[Option[U128]]](plainReader{bytes.NewReader(buffer.Bytes())})
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
+func Test_Decoder_Read_ShortReads(t *testing.T) {
+	input := Sequence[U128]{NewU128(1), MaxU128()}
+	reader := iotest.OneByteReader(bytes.NewReader(input.Bytes()))
+
+	result, err := DecodeSequence[U128](reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
+func Test_Decoder_Read_EOF(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		decode func(reader io.Reader) error
+		expect error
+	}{
+		{
+			label:  "U32 clean EOF",
+			input:  []byte{},
+			decode: func(reader io.Reader) error { _, err := DecodeU32(reader); return err },
+			expect: io.EOF,
+		},
+		{
+			label:  "U32 truncated",
+			input:  []byte{0x01, 0x02},
+			decode: func(reader io.Reader) error { _, err := DecodeU32(reader); return err },
+			expect: &DecodeError{Type: "U32", Offset: 2, Read: 2, Expected: 4, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "I16 truncated",
+			input:  []byte{0x01},
+			decode: func(reader io.Reader) error { _, err := DecodeI16(reader); return err },
+			expect: &DecodeError{Type: "I16", Offset: 1, Read: 1, Expected: 2, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "I32 truncated",
+			input:  []byte{0x01, 0x02, 0x03},
+			decode: func(reader io.Reader) error { _, err := DecodeI32(reader); return err },
+			expect: &DecodeError{Type: "I32", Offset: 3, Read: 3, Expected: 4, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "I64 truncated",
+			input:  []byte{0x01, 0x02},
+			decode: func(reader io.Reader) error { _, err := DecodeI64(reader); return err },
+			expect: &DecodeError{Type: "I64", Offset: 2, Read: 2, Expected: 8, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "I128 truncated",
+			input:  []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+			decode: func(reader io.Reader) error { _, err := DecodeI128(reader); return err },
+			expect: &DecodeError{Type: "I128", Offset: 9, Read: 9, Expected: 16, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Sequence element truncated",
+			input:  []byte{0x08, 0x01, 0x00},
+			decode: func(reader io.Reader) error { _, err := DecodeSequence[U16](reader); return err },
+			expect: &DecodeError{Type: "Sequence", Offset: 3, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Sequence[U8] truncated after the length",
+			input:  []byte{0x08},
+			decode: func(reader io.Reader) error { _, err := DecodeSequence[U8](reader); return err },
+			expect: &DecodeError{Type: "Sequence[U8]", Offset: 1, Read: 0, Expected: 2, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Option value truncated",
+			input:  []byte{0x01},
+			decode: func(reader io.Reader) error { _, err := DecodeOption[U32](reader); return err },
+			expect: &DecodeError{Type: "Option", Offset: 1, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Tuple field truncated",
+			input:  []byte{0x01},
+			decode: func(reader io.Reader) error { return DecodeTuple(&TupleBool{}, reader) },
+			expect: &TupleFieldError{Path: "TupleBool.A1", Err: &DecodeError{Type: "Tuple", Offset: 1, Err: io.ErrUnexpectedEOF}},
+		},
+		{
+			label:  "Compact two-byte mode truncated",
+			input:  []byte{0x01},
+			decode: func(reader io.Reader) error { _, err := DecodeCompact[U32](reader); return err },
+			expect: &DecodeError{Type: "Compact", Offset: 1, Read: 1, Expected: 2, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Compact four-byte mode truncated",
+			input:  []byte{0x02},
+			decode: func(reader io.Reader) error { _, err := DecodeCompact[U32](reader); return err },
+			expect: &DecodeError{Type: "Compact", Offset: 1, Read: 1, Expected: 4, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Compact four-byte mode partially truncated",
+			input:  []byte{0x02, 0x01},
+			decode: func(reader io.Reader) error { _, err := DecodeCompact[U32](reader); return err },
+			expect: &DecodeError{Type: "Compact", Offset: 2, Read: 2, Expected: 4, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Compact big-integer mode truncated",
+			input:  []byte{0x03},
+			decode: func(reader io.Reader) error { _, err := DecodeCompact[U64](reader); return err },
+			expect: &DecodeError{Type: "Compact", Offset: 1, Read: 1, Expected: 5, Err: io.ErrUnexpectedEOF},
+		},
+		{
+			label:  "Compact big-integer mode partially truncated",
+			input:  []byte{0x03, 0x01},
+			decode: func(reader io.Reader) error { _, err := DecodeCompact[U64](reader); return err },
+			expect: &DecodeError{Type: "Compact", Offset: 2, Read: 2, Expected: 5, Err: io.ErrUnexpectedEOF},
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			reader := NewCountingReader(bytes.NewReader(e.input))
+
+			err := e.decode(reader)
+
+			assert.Equal(t, e.expect, err)
+		})
+	}
+}
+
+func Test_DecodeError_Error(t *testing.T) {
+	err := &DecodeError{Type: "U64", Offset: 10, Read: 3, Expected: 8, Err: io.ErrUnexpectedEOF}
+	assert.Equal(t, "can not decode U64 at offset 10: unexpected EOF, read 3 of 8 bytes", err.Error())
+
+	err.Offset = -1
+	assert.Equal(t, "can not decode U64: unexpected EOF, read 3 of 8 bytes", err.Error())
+
+	// between the nested values
+	err = &DecodeError{Type: "Sequence", Offset: 3, Err: io.ErrUnexpectedEOF}
+	assert.Equal(t, "can not decode Sequence at offset 3: unexpected EOF", err.Error())
+}
+
+func Test_CountingReader(t *testing.T) {
+	reader := NewCountingReader(plainReader{bytes.NewReader([]byte{0x04, 0x2a, 0x01, 0x00, 0x00, 0x00})})
+
+	_, err := DecodeSequence[U8](reader)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), reader.Consumed())
+
+	_, err = DecodeU32(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), reader.Consumed())
+
+	_, err = reader.ReadByte()
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, int64(6), reader.Consumed())
+}
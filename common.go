@@ -3,17 +3,33 @@ package goscale
 import (
 	"bytes"
 	"errors"
+	"io"
+	"reflect"
 )
 
 var (
 	errTypeNotFound = errors.New("type not found")
 )
 
+// Encodable is implemented by the types that can be SCALE encoded.
+// Encode writes the encoding to any io.Writer, which allows large values to be
+// streamed, while Bytes returns the encoding as a byte slice.
 type Encodable interface {
-	Encode(buffer *bytes.Buffer) error
+	Encode(writer io.Writer) error
 	Bytes() []byte
 }
 
+// Decodable is implemented by pointers to types that can decode themselves.
+// It allows the generic containers like Sequence[T], Option[T] and Dictionary[K, V]
+// to decode any user-defined element type, e.g.
+//
+//	func (t *MyTuple) Decode(reader io.Reader) error {
+//		return DecodeTuple(t, reader)
+//	}
+type Decodable interface {
+	Decode(reader io.Reader) error
+}
+
 type Ordered interface {
 	I8 | I16 | I32 | I64 | U8 | U16 | U32 | U64 | Str
 }
@@ -24,9 +40,9 @@ func EncodedBytes(e Encodable) []byte {
 	return buffer.Bytes()
 }
 
-func EncodeEach(buffer *bytes.Buffer, encodables ...Encodable) error {
+func EncodeEach(writer io.Writer, encodables ...Encodable) error {
 	for _, encodable := range encodables {
-		err := encodable.Encode(buffer)
+		err := encodable.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -34,45 +50,108 @@ func EncodeEach(buffer *bytes.Buffer, encodables ...Encodable) error {
 	return nil
 }
 
-func decodeByType(i interface{}, buffer *bytes.Buffer) (Encodable, error) {
-	switch i.(type) {
+// decodeInto decodes a value of type T, preferring its Decodable implementation,
+// then the built-in types and finally structs embedding the Tuple type.
+func decodeInto[T Encodable](reader io.Reader) (T, error) {
+	var value T
+
+	if decodable, ok := any(&value).(Decodable); ok {
+		err := decodable.Decode(reader)
+		return value, err
+	}
+
+	if isTuple(reflect.TypeOf(value)) {
+		err := DecodeTuple(&value, reader)
+		return value, err
+	}
+
+	result, err := decodeByType(value, reader)
+	if err != nil {
+		return value, err
+	}
+	return result.(T), nil
+}
+
+func decodeByType(i interface{}, reader io.Reader) (Encodable, error) {
+	switch i := i.(type) {
 	case Bool:
-		return DecodeBool(buffer)
+		return DecodeBool(reader)
 	case U8:
-		return DecodeU8(buffer)
+		return DecodeU8(reader)
 	case I8:
-		return DecodeI8(buffer)
+		return DecodeI8(reader)
 	case U16:
-		return DecodeU16(buffer)
+		return DecodeU16(reader)
 	case I16:
-		return DecodeI16(buffer)
+		return DecodeI16(reader)
 	case U32:
-		return DecodeU32(buffer)
+		return DecodeU32(reader)
 	case I32:
-		return DecodeI32(buffer)
+		return DecodeI32(reader)
 	case U64:
-		return DecodeU64(buffer)
+		return DecodeU64(reader)
 	case I64:
-		return DecodeI64(buffer)
+		return DecodeI64(reader)
 	case U128:
-		return DecodeU128(buffer)
+		return DecodeU128(reader)
 	case I128:
-		return DecodeI128(buffer)
+		return DecodeI128(reader)
+	case U256:
+		return DecodeU256(reader)
+	case I256:
+		return DecodeI256(reader)
 	case Compact:
-		return DecodeCompact[U128](buffer)
+		return DecodeCompact[U128](reader)
+	case CompactOf[U8]:
+		return DecodeCompactOf[U8](reader)
+	case CompactOf[U16]:
+		return DecodeCompactOf[U16](reader)
+	case CompactOf[U32]:
+		return DecodeCompactOf[U32](reader)
+	case CompactOf[U64]:
+		return DecodeCompactOf[U64](reader)
+	case CompactOf[U128]:
+		return DecodeCompactOf[U128](reader)
+	case Percent:
+		return DecodePercent(reader)
+	case Permill:
+		return DecodePermill(reader)
+	case Perbill:
+		return DecodePerbill(reader)
+	case Perquintill:
+		return DecodePerquintill(reader)
+	case FixedU128:
+		return DecodeFixedU128(reader)
+	case FixedI64:
+		return DecodeFixedI64(reader)
+	case FixedI128:
+		return DecodeFixedI128(reader)
+	case Bytes16:
+		return DecodeBytes16(reader)
+	case Bytes20:
+		return DecodeBytes20(reader)
+	case Bytes32:
+		return DecodeBytes32(reader)
+	case Bytes33:
+		return DecodeBytes33(reader)
+	case Bytes64:
+		return DecodeBytes64(reader)
+	case Bytes65:
+		return DecodeBytes65(reader)
 	case Sequence[U8]:
-		dec, err := DecodeSliceU8(buffer)
+		dec, err := DecodeSliceU8(reader)
 		if err != nil {
 			return Empty{}, err
 		}
 		return Sequence[U8](dec), nil
 	case Str:
-		return DecodeStr(buffer)
+		return DecodeStr(reader)
+	case Bytes:
+		return DecodeBytes(reader)
 	case Empty:
 		return DecodeEmpty()
-	// TODO:
-	// case Result[Encodable]:
-	// return DecodeResult(buffer)
+	case result:
+		return i.decodeResult(reader)
 	default:
 		return Empty{}, errTypeNotFound
 	}
@@ -84,7 +163,14 @@ func reverseSlice(a []byte) {
 	}
 }
 
+// ToCompact converts any non-negative integer to Compact, it panics on the negative values
+// of the signed types. NewCompactOf is the typed alternative, it rejects the signed types at
+// compile time.
 func ToCompact(v interface{}) Compact {
+	if isNegativeSigned(v) {
+		panic("negative value in ToCompact()")
+	}
+
 	switch v := v.(type) {
 	case int:
 		return Compact{NewU128(v)}
@@ -126,7 +212,48 @@ func ToCompact(v interface{}) Compact {
 		return Compact{NewU128(v)}
 	case I128:
 		return Compact{NewU128(v)}
+	case U256:
+		return Compact{v}
+	case Percent:
+		return Compact{NewU8(uint8(v))}
+	case Permill:
+		return Compact{NewU32(uint32(v))}
+	case Perbill:
+		return Compact{NewU32(uint32(v))}
+	case Perquintill:
+		return Compact{NewU64(uint64(v))}
+	case I256:
+		return Compact{NewU256(v)}
 	default:
 		panic("invalid numeric type in ToCompact()")
 	}
 }
+
+func isNegativeSigned(v interface{}) bool {
+	switch v := v.(type) {
+	case int:
+		return v < 0
+	case int8:
+		return v < 0
+	case I8:
+		return v < 0
+	case int16:
+		return v < 0
+	case I16:
+		return v < 0
+	case int32:
+		return v < 0
+	case I32:
+		return v < 0
+	case int64:
+		return v < 0
+	case I64:
+		return v < 0
+	case I128:
+		return v.isNegative()
+	case I256:
+		return v.isNegative()
+	default:
+		return false
+	}
+}
@@ -3,6 +3,8 @@ package goscale
 import (
 	"bytes"
 	"errors"
+	"io"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,7 +12,7 @@ import (
 
 type encodableType struct{}
 
-func (e encodableType) Encode(buffer *bytes.Buffer) error {
+func (e encodableType) Encode(writer io.Writer) error {
 	return errors.New("error")
 }
 
@@ -81,3 +83,114 @@ func Test_ToCompact_Panics(t *testing.T) {
 		},
 	)
 }
+
+func Test_ToCompact_Negative(t *testing.T) {
+	var examples = []struct {
+		label string
+		input interface{}
+	}{
+		{label: "ToCompact(int)", input: -1},
+		{label: "ToCompact(int8)", input: int8(-2)},
+		{label: "ToCompact(I8)", input: I8(-3)},
+		{label: "ToCompact(I16)", input: I16(-4)},
+		{label: "ToCompact(int32)", input: int32(-5)},
+		{label: "ToCompact(I64)", input: I64(-6)},
+		{label: "ToCompact(I128)", input: NewI128(-7)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.PanicsWithValue(t, "negative value in ToCompact()", func() {
+				ToCompact(e.input)
+			})
+		})
+	}
+}
+
+type decodableType struct {
+	A U8
+	B Str
+}
+
+func (d decodableType) Encode(writer io.Writer) error {
+	return EncodeEach(writer, d.A, d.B)
+}
+
+func (d decodableType) Bytes() []byte {
+	return EncodedBytes(d)
+}
+
+func (d *decodableType) Decode(reader io.Reader) error {
+	a, err := DecodeU8(reader)
+	if err != nil {
+		return err
+	}
+	b, err := DecodeStr(reader)
+	if err != nil {
+		return err
+	}
+	d.A, d.B = a, b
+	return nil
+}
+
+func Test_Decodable(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		target Decodable
+	}{
+		{label: "Bool", input: Bool(true), target: new(Bool)},
+		{label: "U8", input: U8(1), target: new(U8)},
+		{label: "I8", input: I8(-1), target: new(I8)},
+		{label: "U16", input: U16(2), target: new(U16)},
+		{label: "I16", input: I16(-2), target: new(I16)},
+		{label: "U32", input: U32(3), target: new(U32)},
+		{label: "I32", input: I32(-3), target: new(I32)},
+		{label: "U64", input: U64(4), target: new(U64)},
+		{label: "I64", input: I64(-4), target: new(I64)},
+		{label: "U128", input: NewU128(5), target: new(U128)},
+		{label: "I128", input: NewI128(-5), target: new(I128)},
+		{label: "Str", input: Str("abc"), target: new(Str)},
+		{label: "Empty", input: Empty{}, target: new(Empty)},
+		{label: "Compact", input: Compact{NewU128(6)}, target: new(Compact)},
+		{label: "Sequence[Sequence[U16]]", input: Sequence[Sequence[U16]]{{1, 2}, {}}, target: new(Sequence[Sequence[U16]])},
+		{label: "Sequence[decodableType]", input: Sequence[decodableType]{{1, "a"}, {2, "b"}}, target: new(Sequence[decodableType])},
+		{label: "FixedSequence[U8]", input: FixedSequence[U8]{1, 2}, target: &FixedSequence[U8]{0, 0}},
+		{label: "Dictionary[Str, Sequence[U8]]", input: Dictionary[Str, Sequence[U8]]{"a": {1}, "b": {}}, target: new(Dictionary[Str, Sequence[U8]])},
+		{label: "Option[Option[U8]]", input: Option[Option[U8]]{true, Option[U8]{true, 7}}, target: new(Option[Option[U8]])},
+		{label: "Option[decodableType]", input: Option[decodableType]{true, decodableType{3, "c"}}, target: new(Option[decodableType])},
+		{label: "OptionBool", input: OptionBool{true, false}, target: new(OptionBool)},
+		{label: "Result[Sequence[U8], Str]", input: Err[Sequence[U8], Str]("a"), target: new(Result[Sequence[U8], Str])},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(e.input.Bytes())
+
+			err := e.target.Decode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, reflect.ValueOf(e.target).Elem().Interface())
+			assert.Equal(t, 0, buffer.Len())
+		})
+	}
+}
+
+func Test_Decodable_Error(t *testing.T) {
+	target := Sequence[decodableType]{}
+	buffer := bytes.NewBuffer([]byte{0x04, 0x01, 0x04})
+
+	err := target.Decode(buffer)
+
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, Sequence[decodableType]{}, target)
+}
+
+func Test_decodeInto_Tuple(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x01, 0x00})
+
+	result, err := decodeInto[TupleBool](buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TupleBool{A0: true, A1: false}, result)
+}
@@ -7,16 +7,19 @@ package goscale
 */
 
 import (
-	"bytes"
 	"encoding/binary"
 	"errors"
+	"io"
+	"math"
 	"math/big"
-	"reflect"
+	"math/bits"
 )
 
 var (
 	errCouldNotDecodeCompact = errors.New("could not decode compact")
-	errNotSupported          = errors.New("not supported: n>63 encountered when decoding a compact-encoded uint")
+	errCompactOverflow       = errors.New("compact value overflows the target type")
+	errCompactOutOfRange     = errors.New("non-canonical compact: value is out of range for the mode")
+	errCompactZeroMSB        = errors.New("non-canonical compact: most significant byte is zero")
 )
 
 type Numeric interface {
@@ -27,8 +30,8 @@ type Compact struct {
 	Number Numeric
 }
 
-func (c Compact) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (c Compact) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(c.Bytes())
 }
 
@@ -84,107 +87,316 @@ func (c Compact) Bytes() []byte {
 	return append([]byte{(topSixBits << 2) + 3}, b...)
 }
 
-func DecodeCompact[T Numeric](buffer *bytes.Buffer) (Compact, error) {
-	decoder := Decoder{Reader: buffer}
-	result := make([]byte, 16)
-	b, err := decoder.DecodeByte()
+func (c Compact) EncodedLen() int {
+	return compactBigIntEncodedLen(c.ToBigInt())
+}
+
+// DecodeCompact decodes a compact integer, rejecting non-canonical encodings
+// if the reader is a LimitedReader with DecodeOptions.Strict set.
+// It fails with errCompactOverflow if the value does not fit in T.
+func DecodeCompact[T Numeric](reader io.Reader) (Compact, error) {
+	return decodeCompact[T](reader, isStrict(reader))
+}
+
+// DecodeCompactStrict decodes a compact integer and rejects the encodings
+// that are not the shortest possible for the value, like Substrate does.
+func DecodeCompactStrict[T Numeric](reader io.Reader) (Compact, error) {
+	return decodeCompact[T](reader, true)
+}
+
+// DecodeCompactBigInt decodes a compact integer of the whole range up to 2^536-1.
+func DecodeCompactBigInt(reader io.Reader) (*big.Int, error) {
+	value, bn, err := decodeCompactInteger(reader, isStrict(reader))
+	if err != nil {
+		return nil, err
+	}
+	if bn == nil {
+		bn = new(big.Int).SetUint64(value)
+	}
+	return bn, nil
+}
+
+func decodeCompact[T Numeric](reader io.Reader, strict bool) (Compact, error) {
+	value, bn, err := decodeCompactInteger(reader, strict)
 	if err != nil {
 		return Compact{}, err
 	}
-	mode := b & 3
-	var value Numeric
-	switch mode {
+
+	number, err := compactNumeric[T](value, bn)
+	if err != nil {
+		return Compact{}, err
+	}
+	v, ok := number.(T)
+	if !ok {
+		return Compact{}, errCouldNotDecodeCompact
+	}
+	return Compact{v}, nil
+}
+
+// decodeCompactInteger decodes a compact integer, which is returned as uint64
+// if it fits in 64 bits and as *big.Int otherwise.
+func decodeCompactInteger(reader io.Reader, strict bool) (uint64, *big.Int, error) {
+	decoder := Decoder{Reader: reader, Type: "Compact"}
+	b, err := decoder.DecodeByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch b & 3 {
 	case 0:
-		switch reflect.TypeOf(*new(T)) {
-		case reflect.TypeOf(*new(U128)):
-			value = Numeric(NewU128(big.NewInt(0).SetUint64(uint64(b >> 2))))
-		case reflect.TypeOf(*new(U64)):
-			value = Numeric(NewU64(uint64(b >> 2)))
-		case reflect.TypeOf(*new(U32)):
-			value = Numeric(NewU32(uint32(b >> 2)))
-		case reflect.TypeOf(*new(U16)):
-			value = Numeric(NewU16(uint16(b >> 2)))
-		case reflect.TypeOf(*new(U8)):
-			value = Numeric(NewU8(b >> 2))
-		default:
-			value = Numeric(NewU128(big.NewInt(0).SetUint64(uint64(b >> 2))))
-		}
-		v := value.(T)
-		return Compact{v}, nil
+		return uint64(b >> 2), nil, nil
 	case 1:
-		db, err := decoder.DecodeByte()
+		buf := make([]byte, 1)
+		err := decoder.ReadTail(buf, 1)
 		if err != nil {
-			return Compact{}, err
-		}
-		r := uint64(db)
-		r <<= 6
-		r += uint64(b >> 2)
-		switch reflect.TypeOf(*new(T)) {
-		case reflect.TypeOf(*new(U128)):
-			value = Numeric(NewU128(r))
-		case reflect.TypeOf(*new(U64)):
-			value = Numeric(NewU64(r))
-		case reflect.TypeOf(*new(U32)):
-			value = Numeric(NewU32(uint32(r)))
-		case reflect.TypeOf(*new(U16)):
-			value = Numeric(NewU16(uint16(r)))
-		case reflect.TypeOf(*new(U8)):
-			value = Numeric(NewU8(uint8(r)))
-		default:
-			value = Numeric(NewU128(r))
-		}
-		v := value.(T)
-		return Compact{v}, nil
+			return 0, nil, err
+		}
+		r := uint64(buf[0])<<6 + uint64(b>>2)
+		if strict && r < 1<<6 {
+			return 0, nil, errCompactOutOfRange
+		}
+		return r, nil, nil
 	case 2:
-		buf := result[:4]
+		buf := make([]byte, 4)
 		buf[0] = b
-		err := decoder.Read(result[1:4])
+		err := decoder.ReadTail(buf[1:], 1)
+		if err != nil {
+			return 0, nil, err
+		}
+		r := binary.LittleEndian.Uint32(buf) >> 2
+		if strict && r < 1<<14 {
+			return 0, nil, errCompactOutOfRange
+		}
+		return uint64(r), nil, nil
+	default:
+		// the upper six bits are the number of bytes following, minus four
+		n := int(b>>2) + 4
+		buf := make([]byte, n)
+		err := decoder.ReadTail(buf, 1)
+		if err != nil {
+			return 0, nil, err
+		}
+		if strict && buf[n-1] == 0 {
+			return 0, nil, errCompactZeroMSB
+		}
+
+		if n <= 8 {
+			var le [8]byte
+			copy(le[:], buf)
+			r := binary.LittleEndian.Uint64(le[:])
+			if strict && r < 1<<30 {
+				return 0, nil, errCompactOutOfRange
+			}
+			return r, nil, nil
+		}
+
+		reverseSlice(buf)
+		bn := new(big.Int).SetBytes(buf)
+		if bn.IsUint64() {
+			// only reachable for non-canonical encodings with zero upper bytes
+			return bn.Uint64(), nil, nil
+		}
+		return 0, bn, nil
+	}
+}
+
+// compactNumeric converts a decoded compact integer to T, or to U128 if T is not
+// one of the unsigned integer types, failing if the value does not fit in it.
+func compactNumeric[T Numeric](value uint64, bn *big.Int) (Numeric, error) {
+	switch any(*new(T)).(type) {
+	case U8:
+		return compactValue[U8](value, bn)
+	case U16:
+		return compactValue[U16](value, bn)
+	case U32:
+		return compactValue[U32](value, bn)
+	case U64:
+		return compactValue[U64](value, bn)
+	case U256:
+		if bn == nil {
+			return U256{U64(value)}, nil
+		}
+		if bn.BitLen() > 256 {
+			return nil, errCompactOverflow
+		}
+		return bigIntToU256(bn), nil
+	default:
+		return compactValue[U128](value, bn)
+	}
+}
+
+// compactValue converts a decoded compact integer to T, failing if the value does not fit in it.
+func compactValue[T CompactInteger](value uint64, bn *big.Int) (T, error) {
+	var result T
+	switch r := any(&result).(type) {
+	case *U8:
+		if bn != nil || value > math.MaxUint8 {
+			return result, errCompactOverflow
+		}
+		*r = U8(value)
+	case *U16:
+		if bn != nil || value > math.MaxUint16 {
+			return result, errCompactOverflow
+		}
+		*r = U16(value)
+	case *U32:
+		if bn != nil || value > math.MaxUint32 {
+			return result, errCompactOverflow
+		}
+		*r = U32(value)
+	case *U64:
+		if bn != nil {
+			return result, errCompactOverflow
+		}
+		*r = U64(value)
+	case *U128:
+		if bn == nil {
+			*r = U128{U64(value), 0}
+		} else if bn.BitLen() > 128 {
+			return result, errCompactOverflow
+		} else {
+			*r = NewU128(bn)
+		}
+	}
+	return result, nil
+}
+
+func (c *Compact) Decode(reader io.Reader) error {
+	result, err := DecodeCompact[U128](reader)
+	if err != nil {
+		return err
+	}
+	*c = result
+	return nil
+}
+
+// CompactInteger are the types that can be encoded as CompactOf[T].
+type CompactInteger interface {
+	U8 | U16 | U32 | U64 | U128
+	Numeric
+}
+
+// CompactOf is a compact encoded unsigned integer of type T.
+// Unlike Compact it holds the value without boxing it in an interface,
+// and the signed types are rejected at compile time.
+type CompactOf[T CompactInteger] struct {
+	value T
+}
+
+func NewCompactOf[T CompactInteger](value T) CompactOf[T] {
+	return CompactOf[T]{value}
+}
+
+func (c CompactOf[T]) Value() T {
+	return c.value
+}
+
+func (c CompactOf[T]) ToBigInt() *big.Int {
+	return c.value.ToBigInt()
+}
+
+// Encode writes the values that fit in 64 bits byte by byte,
+// so that no allocations are made if the writer is an io.ByteWriter.
+func (c CompactOf[T]) Encode(writer io.Writer) error {
+	value, ok := c.uint64()
+	if !ok {
+		return Compact{c.value}.Encode(writer)
+	}
+
+	encoder := Encoder{Writer: writer}
+	switch {
+	case value < 1<<6:
+		return encoder.EncodeByte(byte(value) << 2)
+	case value < 1<<14:
+		return encodeCompactBytes(encoder, value<<2|1, 2)
+	case value < 1<<30:
+		return encodeCompactBytes(encoder, value<<2|2, 4)
+	default:
+		n := (bits.Len64(value) + 7) / 8
+		err := encoder.EncodeByte(byte(n-4)<<2 | 3)
 		if err != nil {
-			return Compact{nil}, err
-		}
-		r := binary.LittleEndian.Uint32(buf)
-		r >>= 2
-		switch reflect.TypeOf(*new(T)) {
-		case reflect.TypeOf(*new(U128)):
-			value = Numeric(NewU128(uint64(r)))
-		case reflect.TypeOf(*new(U64)):
-			value = Numeric(NewU64(uint64(r)))
-		case reflect.TypeOf(*new(U32)):
-			value = Numeric(NewU32(r))
-		case reflect.TypeOf(*new(U16)):
-			value = Numeric(NewU16(uint16(r)))
-		default:
-			value = Numeric(NewU128(r))
-		}
-		v := value.(T)
-		return Compact{v}, nil
-	case 3:
-		n := b >> 2
-		if n > 63 {
-			value := NewU64(0)
-			result := interface{}(value).(T)
-			return Compact{result}, errNotSupported
-		}
-		err := decoder.Read(result[:n+4])
+			return err
+		}
+		return encodeCompactBytes(encoder, value, n)
+	}
+}
+
+func (c CompactOf[T]) Bytes() []byte {
+	return EncodedBytes(c)
+}
+
+func (c CompactOf[T]) EncodedLen() int {
+	value, ok := c.uint64()
+	if !ok {
+		return compactBigIntEncodedLen(c.ToBigInt())
+	}
+	return compactEncodedLen(value)
+}
+
+// MaxEncodedLen is the length of the maximum value of T.
+func (c CompactOf[T]) MaxEncodedLen() int {
+	switch any(c.value).(type) {
+	case U8:
+		return compactEncodedLen(math.MaxUint8)
+	case U16:
+		return compactEncodedLen(math.MaxUint16)
+	case U32:
+		return compactEncodedLen(math.MaxUint32)
+	case U64:
+		return compactEncodedLen(math.MaxUint64)
+	default:
+		return 1 + 16
+	}
+}
+
+// uint64 returns the value if it fits in 64 bits.
+func (c CompactOf[T]) uint64() (uint64, bool) {
+	switch v := any(c.value).(type) {
+	case U8:
+		return uint64(v), true
+	case U16:
+		return uint64(v), true
+	case U32:
+		return uint64(v), true
+	case U64:
+		return uint64(v), true
+	case U128:
+		return uint64(v[0]), v[1] == 0
+	}
+	return 0, false
+}
+
+// allows reflection based codecs to tell CompactOf[T] apart from the tuples
+func (c CompactOf[T]) compactOf() {}
+
+func DecodeCompactOf[T CompactInteger](reader io.Reader) (CompactOf[T], error) {
+	value, bn, err := decodeCompactInteger(reader, isStrict(reader))
+	if err != nil {
+		return CompactOf[T]{}, err
+	}
+	result, err := compactValue[T](value, bn)
+	if err != nil {
+		return CompactOf[T]{}, err
+	}
+	return CompactOf[T]{result}, nil
+}
+
+func (c *CompactOf[T]) Decode(reader io.Reader) error {
+	result, err := DecodeCompactOf[T](reader)
+	if err != nil {
+		return err
+	}
+	*c = result
+	return nil
+}
+
+// encodeCompactBytes writes the n least significant bytes of value in little endian order.
+func encodeCompactBytes(encoder Encoder, value uint64, n int) error {
+	for i := 0; i < n; i++ {
+		err := encoder.EncodeByte(byte(value >> (8 * i)))
 		if err != nil {
-			return Compact{nil}, err
-		}
-		reverseSlice(result)
-		switch reflect.TypeOf(*new(T)) {
-		case reflect.TypeOf(*new(U128)):
-			value = Numeric(NewU128(big.NewInt(0).SetBytes(result)))
-		case reflect.TypeOf(*new(U64)):
-			value = Numeric(NewU64(big.NewInt(0).SetBytes(result).Uint64()))
-		case reflect.TypeOf(*new(U32)):
-			value = Numeric(NewU32(uint32(big.NewInt(0).SetBytes(result).Uint64())))
-		default:
-			value = Numeric(NewU128(big.NewInt(0).SetBytes(result)))
-		}
-		v, ok := value.(T)
-		if !ok {
-			return Compact{v}, errCouldNotDecodeCompact
-		}
-		return Compact{v}, nil
-	}
-	return Compact{}, errCouldNotDecodeCompact
+			return err
+		}
+	}
+	return nil
 }
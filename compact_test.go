@@ -222,3 +222,234 @@ func Test_DecodeCompact_Empty(t *testing.T) {
 	assert.Equal(t, io.EOF, err)
 	assert.Equal(t, Compact{}, result)
 }
+
+func Test_DecodeCompactStrict(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect Compact
+	}{
+		{label: "Decode Compact(0)  Mode 0", input: []byte{0x00}, expect: Compact{NewU128(0)}},
+		{label: "Decode Compact(64) Mode 1", input: []byte{0x01, 0x01}, expect: Compact{NewU128(64)}},
+		{label: "Decode Compact(16384) Mode 2", input: []byte{0x02, 0x00, 0x01, 0x00}, expect: Compact{NewU128(16384)}},
+		{label: "Decode Compact(1073741824) Mode 3", input: []byte{0x03, 0x00, 0x00, 0x00, 0x40}, expect: Compact{NewU128(1 << 30)}},
+		{label: "Decode Compact(4294967296) Mode 3", input: []byte{0x07, 0x00, 0x00, 0x00, 0x00, 0x01}, expect: Compact{NewU128(1 << 32)}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := DecodeCompactStrict[U128](bytes.NewBuffer(e.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+		})
+	}
+}
+
+func Test_DecodeCompactStrict_NonCanonical(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect error
+	}{
+		{label: "Compact(1) Mode 1", input: []byte{0x05, 0x00}, expect: errCompactOutOfRange},
+		{label: "Compact(63) Mode 1", input: []byte{0xfd, 0x00}, expect: errCompactOutOfRange},
+		{label: "Compact(1) Mode 2", input: []byte{0x06, 0x00, 0x00, 0x00}, expect: errCompactOutOfRange},
+		{label: "Compact(16383) Mode 2", input: []byte{0xfe, 0xff, 0x00, 0x00}, expect: errCompactOutOfRange},
+		{label: "Compact(1) Mode 3", input: []byte{0x03, 0x01, 0x00, 0x00, 0x01}, expect: errCompactOutOfRange},
+		{label: "Compact(1073741823) Mode 3", input: []byte{0x03, 0xff, 0xff, 0xff, 0x3f}, expect: errCompactOutOfRange},
+		{label: "Compact(1) Mode 3 with trailing zero bytes", input: []byte{0x07, 0x01, 0x00, 0x00, 0x00, 0x00}, expect: errCompactZeroMSB},
+		{label: "Compact(2^32) Mode 3 with trailing zero byte", input: []byte{0x0b, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}, expect: errCompactZeroMSB},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			_, err := DecodeCompactStrict[U128](bytes.NewBuffer(e.input))
+			assert.ErrorIs(t, err, e.expect)
+
+			_, err = DecodeCompact[U128](NewLimitedReader(bytes.NewBuffer(e.input), DecodeOptions{Strict: true}))
+			assert.ErrorIs(t, err, e.expect)
+
+			_, err = DecodeCompact[U128](bytes.NewBuffer(e.input))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_DecodeLength_Strict(t *testing.T) {
+	// Sequence[U8]{1} with its length prefix in two-byte mode
+	input := []byte{0x05, 0x00, 0x01}
+
+	_, err := DecodeLengthStrict(bytes.NewBuffer(input))
+	assert.ErrorIs(t, err, errCompactOutOfRange)
+
+	_, err = DecodeSequence[U8](NewLimitedReader(bytes.NewBuffer(input), DecodeOptions{Strict: true}))
+	assert.ErrorIs(t, err, errCompactOutOfRange)
+
+	_, err = DecodeDictionary[U8, U8](NewLimitedReader(bytes.NewBuffer(input), DecodeOptions{Strict: true}))
+	assert.ErrorIs(t, err, errCompactOutOfRange)
+
+	result, err := DecodeSequence[U8](bytes.NewBuffer(input))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[U8]{1}, result)
+}
+
+func Test_DecodeCompactBigInt(t *testing.T) {
+	max536 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 536), big.NewInt(1))
+	u128Max := U128{math.MaxUint64, math.MaxUint64}.ToBigInt()
+
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect *big.Int
+	}{
+		{label: "Decode Compact(42) Mode 0", input: []byte{0xa8}, expect: big.NewInt(42)},
+		{label: "Decode Compact(16384) Mode 2", input: []byte{0x02, 0x00, 0x01, 0x00}, expect: big.NewInt(16384)},
+		{label: "Decode Compact(math.MaxUint64)", input: []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, expect: new(big.Int).SetUint64(math.MaxUint64)},
+		{label: "Decode Compact(2^128-1)", input: Compact{U128{math.MaxUint64, math.MaxUint64}}.Bytes(), expect: u128Max},
+		{label: "Decode Compact(2^128)", input: append([]byte{0x37}, append(make([]byte, 16), 0x01)...), expect: new(big.Int).Add(u128Max, big.NewInt(1))},
+		{label: "Decode Compact(2^536-1)", input: append([]byte{0xff}, bytes.Repeat([]byte{0xff}, 67)...), expect: max536},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(e.input)
+
+			result, err := DecodeCompactBigInt(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, e.expect.Cmp(result))
+			assert.Equal(t, 0, buffer.Len())
+		})
+	}
+}
+
+func Test_DecodeCompact_Overflow(t *testing.T) {
+	u128Overflow := append([]byte{0x37}, append(make([]byte, 16), 0x01)...)
+	u64Overflow := []byte{0x17, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	_, err := DecodeCompact[U8](bytes.NewBuffer([]byte{0x01, 0x04}))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeCompact[U16](bytes.NewBuffer([]byte{0x02, 0x00, 0x04, 0x00}))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeCompact[U32](bytes.NewBuffer([]byte{0x07, 0x00, 0x00, 0x00, 0x00, 0x01}))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeCompact[U64](bytes.NewBuffer(u64Overflow))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeCompact[U128](bytes.NewBuffer(u128Overflow))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeLength(bytes.NewBuffer(u64Overflow))
+	assert.ErrorIs(t, err, errLengthOverflow)
+}
+
+func Test_DecodeCompact_SmallTypesAnyMode(t *testing.T) {
+	// Compact(255) in the non-canonical four-byte mode
+	result, err := DecodeCompact[U8](bytes.NewBuffer([]byte{0xfe, 0x03, 0x00, 0x00}))
+	assert.NoError(t, err)
+	assert.Equal(t, Compact{NewU8(255)}, result)
+
+	// Compact(65535) in the non-canonical big-integer mode
+	result, err = DecodeCompact[U16](bytes.NewBuffer([]byte{0x03, 0xff, 0xff, 0x00, 0x00}))
+	assert.NoError(t, err)
+	assert.Equal(t, Compact{NewU16(65535)}, result)
+}
+
+func Test_DecodeCompact_BigIntegerTruncated(t *testing.T) {
+	input := append([]byte{0xff}, bytes.Repeat([]byte{0xff}, 66)...)
+
+	_, err := DecodeCompactBigInt(bytes.NewBuffer(input))
+
+	assert.Equal(t, &DecodeError{Type: "Compact", Offset: -1, Read: 67, Expected: 68, Err: io.ErrUnexpectedEOF}, err)
+}
+
+func Test_EncodeCompactOf(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		expect []byte
+	}{
+		{label: "Encode CompactOf[U8](0)", input: NewCompactOf(U8(0)), expect: []byte{0x00}},
+		{label: "Encode CompactOf[U8](255)", input: NewCompactOf(U8(255)), expect: []byte{0xfd, 0x03}},
+		{label: "Encode CompactOf[U16](16383)", input: NewCompactOf(U16(16383)), expect: []byte{0xfd, 0xff}},
+		{label: "Encode CompactOf[U16](65535)", input: NewCompactOf(U16(65535)), expect: []byte{0xfe, 0xff, 0x03, 0x00}},
+		{label: "Encode CompactOf[U32](1073741823)", input: NewCompactOf(U32(1073741823)), expect: []byte{0xfe, 0xff, 0xff, 0xff}},
+		{label: "Encode CompactOf[U32](1073741824)", input: NewCompactOf(U32(1073741824)), expect: []byte{0x03, 0x00, 0x00, 0x00, 0x40}},
+		{label: "Encode CompactOf[U64](1<<32)", input: NewCompactOf(U64(1 << 32)), expect: []byte{0x07, 0x00, 0x00, 0x00, 0x00, 0x01}},
+		{label: "Encode CompactOf[U64](math.MaxUint64)", input: NewCompactOf(U64(math.MaxUint64)), expect: []byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{label: "Encode CompactOf[U128](100000000000000)", input: NewCompactOf(NewU128(100000000000000)), expect: []byte{0x0b, 0x00, 0x40, 0x7a, 0x10, 0xf3, 0x5a}},
+		{label: "Encode CompactOf[U128](MaxU128)", input: NewCompactOf(U128{math.MaxUint64, math.MaxUint64}), expect: []byte{0x33, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+			assert.Equal(t, Compact{e.input.(Numeric)}.Bytes(), e.input.Bytes())
+		})
+	}
+}
+
+func Test_EncodeCompactOf_NoAllocations(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.Grow(64)
+
+	values := []CompactOf[U64]{NewCompactOf(U64(42)), NewCompactOf(U64(16383)), NewCompactOf(U64(1 << 29)), NewCompactOf(U64(math.MaxUint64))}
+	for _, value := range values {
+		allocs := testing.AllocsPerRun(100, func() {
+			buffer.Reset()
+			value.Encode(buffer)
+		})
+		assert.Equal(t, float64(0), allocs)
+	}
+}
+
+func Test_DecodeCompactOf(t *testing.T) {
+	result8, err := DecodeCompactOf[U8](bytes.NewBuffer([]byte{0xfd, 0x03}))
+	assert.NoError(t, err)
+	assert.Equal(t, U8(255), result8.Value())
+
+	result32, err := DecodeCompactOf[U32](bytes.NewBuffer([]byte{0x03, 0xff, 0xff, 0xff, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, U32(math.MaxUint32), result32.Value())
+
+	result64, err := DecodeCompactOf[U64](bytes.NewBuffer([]byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, U64(math.MaxUint64), result64.Value())
+
+	result128, err := DecodeCompactOf[U128](bytes.NewBuffer(NewCompactOf(U128{1, 1}).Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, U128{1, 1}, result128.Value())
+
+	_, err = DecodeCompactOf[U8](bytes.NewBuffer([]byte{0x01, 0x04}))
+	assert.ErrorIs(t, err, errCompactOverflow)
+
+	_, err = DecodeCompactOf[U16](NewLimitedReader(bytes.NewBuffer([]byte{0x05, 0x00}), DecodeOptions{Strict: true}))
+	assert.ErrorIs(t, err, errCompactOutOfRange)
+
+	_, err = DecodeCompactOf[U32](bytes.NewBuffer([]byte{}))
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_DecodeCompactOf_Sequence(t *testing.T) {
+	input := Sequence[CompactOf[U32]]{NewCompactOf(U32(1)), NewCompactOf(U32(1 << 20))}
+
+	var result Sequence[CompactOf[U32]]
+	err := result.Decode(bytes.NewBuffer(input.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	value, err := decodeByType(CompactOf[U64]{}, bytes.NewBuffer([]byte{0xa8}))
+	assert.NoError(t, err)
+	assert.Equal(t, NewCompactOf(U64(42)), value)
+}
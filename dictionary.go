@@ -8,36 +8,60 @@ package goscale
 
 import (
 	"bytes"
+	"cmp"
+	"errors"
+	"io"
+	"reflect"
 	"sort"
 )
 
+var (
+	errDictionaryNotCanonical = errors.New("dictionary keys are not sorted or not unique")
+	errKeyNotOrdered          = errors.New("key type has no order, it must implement Lesser")
+)
+
+// Comparable keys are ordered like in Rust. The keys implementing Lesser are ordered
+// by their Less method, the numeric types (including the per-things, the fixed-point
+// types and CompactOf) by their values, Str lexicographically, Option with None first,
+// the tuples field by field and the byte arrays, e.g. Bytes32, lexicographically.
+// The dictionaries of the other key types fail to encode.
 type Comparable interface {
 	Encodable
-	Ordered
+	comparable
+}
+
+// Lesser is implemented by the keys with a custom order.
+type Lesser[T any] interface {
+	Less(other T) bool
 }
 
 type Dictionary[K Comparable, V Encodable] map[K]V
 
-func (d Dictionary[K, V]) Encode(buffer *bytes.Buffer) error {
-	err := ToCompact(len(d)).Encode(buffer)
+func (d Dictionary[K, V]) Encode(writer io.Writer) error {
+	err := checkKeyOrder[K]()
+	if err != nil {
+		return err
+	}
+
+	err = ToCompact(len(d)).Encode(writer)
 	if err != nil {
 		return err
 	}
 
-	keys := make([]K, 0)
+	keys := make([]K, 0, len(d))
 	for k := range d {
 		keys = append(keys, k)
 	}
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	sortKeys(keys)
 
 	for _, k := range keys {
 		v := d[k]
-		err := k.Encode(buffer)
+		err := k.Encode(writer)
 		if err != nil {
 			return err
 		}
 
-		err = v.Encode(buffer)
+		err = v.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -50,26 +74,291 @@ func (d Dictionary[K, V]) Bytes() []byte {
 	return EncodedBytes(d)
 }
 
-func DecodeDictionary[K Comparable, V Encodable](buffer *bytes.Buffer) (Dictionary[K, V], error) {
+func (d Dictionary[K, V]) EncodedLen() int {
+	size := compactEncodedLen(uint64(len(d)))
+	for k, v := range d {
+		size += EncodedLen(k) + EncodedLen(v)
+	}
+	return size
+}
+
+// DecodeDictionary keeps the last value of the duplicate keys, unless the reader is a LimitedReader
+// with DecodeOptions.Strict set, which rejects the keys that are not in ascending order.
+func DecodeDictionary[K Comparable, V Encodable](reader io.Reader) (Dictionary[K, V], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDictionary[K, V](reader, size, isStrict(reader))
+}
+
+// DecodeDictionaryStrict rejects the keys that are not in ascending order regardless of the reader options.
+func DecodeDictionaryStrict[K Comparable, V Encodable](reader io.Reader) (Dictionary[K, V], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDictionary[K, V](reader, size, true)
+}
+
+// decodeDictionary decodes the entries following the length prefix.
+func decodeDictionary[K Comparable, V Encodable](reader io.Reader, size int, strict bool) (Dictionary[K, V], error) {
 	result := Dictionary[K, V]{}
 
-	v, err := DecodeCompact[U128](buffer)
+	err := descend(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, size, reflect.TypeOf(*new(K)), reflect.TypeOf(*new(V)))
 	if err != nil {
 		return nil, err
 	}
-	size := int(v.ToBigInt().Int64())
 
+	if strict {
+		err = checkKeyOrder[K]()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var previous K
 	for i := 0; i < size; i++ {
-		key, err := decodeByType(*new(K), buffer)
+		key, err := decodeInto[K](reader)
 		if err != nil {
-			return Dictionary[K, V]{}, err
+			return Dictionary[K, V]{}, Decoder{Reader: reader, Type: "Dictionary"}.Truncated(err)
 		}
-		value, err := decodeByType(*new(V), buffer)
+		if strict && i > 0 && !lessKey(previous, key) {
+			return Dictionary[K, V]{}, errDictionaryNotCanonical
+		}
+		previous = key
+		value, err := decodeInto[V](reader)
 		if err != nil {
-			return Dictionary[K, V]{}, err
+			return Dictionary[K, V]{}, Decoder{Reader: reader, Type: "Dictionary"}.Truncated(err)
 		}
-		result[key.(K)] = value.(V)
+		result[key] = value
 	}
 
 	return result, nil
 }
+
+func (d *Dictionary[K, V]) Decode(reader io.Reader) error {
+	result, err := DecodeDictionary[K, V](reader)
+	if err != nil {
+		return err
+	}
+	*d = result
+	return nil
+}
+
+// allows reflection based codecs to order the keys of Dictionary[K, V]
+func (d Dictionary[K, V]) lessKeys(a, b any) bool {
+	return lessKey(a.(K), b.(K))
+}
+
+// keysOrdered reports whether the keys of Dictionary[K, V] can be ordered.
+func (d Dictionary[K, V]) keysOrdered() bool {
+	return checkKeyOrder[K]() == nil
+}
+
+func lessKey[K Comparable](a, b K) bool {
+	if lesser, ok := any(a).(Lesser[K]); ok {
+		return lesser.Less(b)
+	}
+	return compareKeys(a, b) < 0
+}
+
+// checkKeyOrder fails if the keys of type K have no order like in Rust and do not implement Lesser.
+func checkKeyOrder[K Comparable]() error {
+	if _, ok := any(*new(K)).(Lesser[K]); ok {
+		return nil
+	}
+	if !orderedKey(reflect.TypeOf(*new(K))) {
+		return errKeyNotOrdered
+	}
+	return nil
+}
+
+// orderedKey reports whether compareKeys orders the values of type t.
+func orderedKey(t reflect.Type) bool {
+	if t == nil {
+		// interfaces like Encodable
+		return false
+	}
+
+	zero := reflect.Zero(t).Interface()
+	if _, ok := compareBuiltin(zero, zero); ok {
+		return true
+	}
+	if _, ok := zero.(optional); ok {
+		// Option[T] { HasValue Bool, Value T }
+		return orderedKey(t.Field(1).Type)
+	}
+	if isTuple(t) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Type == reflect.TypeOf(*new(Tuple)) {
+				continue
+			}
+			if !orderedKey(field.Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return isByteArray(t)
+}
+
+func isByteArray(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8
+}
+
+func sortKeys[K Comparable](keys []K) {
+	var zero K
+	_, isLesser := any(zero).(Lesser[K])
+	if isLesser || !isByteArray(reflect.TypeOf(zero)) {
+		sort.Slice(keys, func(i, j int) bool { return lessKey(keys[i], keys[j]) })
+		return
+	}
+
+	// the bytes of the byte array keys are copied once
+	encoded := make([][]byte, len(keys))
+	for i, k := range keys {
+		encoded[i] = arrayBytes(reflect.ValueOf(k))
+	}
+	sort.Sort(keysByBytes[K]{keys, encoded})
+}
+
+type keysByBytes[K Comparable] struct {
+	keys    []K
+	encoded [][]byte
+}
+
+func (k keysByBytes[K]) Len() int {
+	return len(k.keys)
+}
+
+func (k keysByBytes[K]) Less(i, j int) bool {
+	return bytes.Compare(k.encoded[i], k.encoded[j]) < 0
+}
+
+func (k keysByBytes[K]) Swap(i, j int) {
+	k.keys[i], k.keys[j] = k.keys[j], k.keys[i]
+	k.encoded[i], k.encoded[j] = k.encoded[j], k.encoded[i]
+}
+
+func arrayBytes(v reflect.Value) []byte {
+	result := make([]byte, v.Len())
+	for i := range result {
+		result[i] = byte(v.Index(i).Uint())
+	}
+	return result
+}
+
+// compareKeys orders the values like Ord in Rust: the numeric types by their values,
+// Option with None first, the tuples field by field and the byte arrays, e.g. Bytes32,
+// lexicographically. The other types are not ordered, see orderedKey.
+func compareKeys(a, b any) int {
+	if result, ok := compareBuiltin(a, b); ok {
+		return result
+	}
+
+	if _, ok := a.(optional); ok {
+		aVal, bVal := reflect.ValueOf(a), reflect.ValueOf(b)
+		aHas, bHas := aVal.Field(0).Bool(), bVal.Field(0).Bool()
+		if !aHas || !bHas {
+			return cmp.Compare(boolToInt(aHas), boolToInt(bHas))
+		}
+		return compareKeys(aVal.Field(1).Interface(), bVal.Field(1).Interface())
+	}
+
+	t := reflect.TypeOf(a)
+	if isTuple(t) {
+		aVal, bVal := reflect.ValueOf(a), reflect.ValueOf(b)
+		for i := 0; i < aVal.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Type == reflect.TypeOf(*new(Tuple)) {
+				continue
+			}
+			result := compareKeys(aVal.Field(i).Interface(), bVal.Field(i).Interface())
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+
+	if t != nil && isByteArray(t) {
+		return bytes.Compare(arrayBytes(reflect.ValueOf(a)), arrayBytes(reflect.ValueOf(b)))
+	}
+	return 0
+}
+
+func compareBuiltin(a, b any) (int, bool) {
+	switch a := a.(type) {
+	case Bool:
+		return cmp.Compare(boolToInt(bool(a)), boolToInt(bool(b.(Bool)))), true
+	case U8:
+		return cmp.Compare(a, b.(U8)), true
+	case I8:
+		return cmp.Compare(a, b.(I8)), true
+	case U16:
+		return cmp.Compare(a, b.(U16)), true
+	case I16:
+		return cmp.Compare(a, b.(I16)), true
+	case U32:
+		return cmp.Compare(a, b.(U32)), true
+	case I32:
+		return cmp.Compare(a, b.(I32)), true
+	case U64:
+		return cmp.Compare(a, b.(U64)), true
+	case I64:
+		return cmp.Compare(a, b.(I64)), true
+	case U128:
+		return cmp128(a, b.(U128)), true
+	case I128:
+		return cmpI128(a, b.(I128)), true
+	case U256:
+		return cmp256(a, b.(U256)), true
+	case I256:
+		return cmpI256(a, b.(I256)), true
+	case Str:
+		return cmp.Compare(a, b.(Str)), true
+	case OptionBool:
+		return compareKeys(Option[Bool](a), Option[Bool](b.(OptionBool))), true
+	case Percent:
+		return cmp.Compare(a, b.(Percent)), true
+	case Permill:
+		return cmp.Compare(a, b.(Permill)), true
+	case Perbill:
+		return cmp.Compare(a, b.(Perbill)), true
+	case Perquintill:
+		return cmp.Compare(a, b.(Perquintill)), true
+	case FixedU128:
+		return cmp128(U128(a), U128(b.(FixedU128))), true
+	case FixedI64:
+		return cmp.Compare(a, b.(FixedI64)), true
+	case FixedI128:
+		return cmpI128(I128(a), I128(b.(FixedI128))), true
+	case CompactOf[U8]:
+		return cmp.Compare(a.Value(), b.(CompactOf[U8]).Value()), true
+	case CompactOf[U16]:
+		return cmp.Compare(a.Value(), b.(CompactOf[U16]).Value()), true
+	case CompactOf[U32]:
+		return cmp.Compare(a.Value(), b.(CompactOf[U32]).Value()), true
+	case CompactOf[U64]:
+		return cmp.Compare(a.Value(), b.(CompactOf[U64]).Value()), true
+	case CompactOf[U128]:
+		return cmp128(a.Value(), b.(CompactOf[U128]).Value()), true
+	default:
+		return 0, false
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
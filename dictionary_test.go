@@ -135,3 +135,256 @@ func Test_DecodeDictionary_Empty(t *testing.T) {
 	assert.Equal(t, io.EOF, err)
 	assert.Equal(t, Dictionary[U8, Str](nil), result)
 }
+
+type TupleKey struct {
+	Tuple
+	A U8
+	B Str
+}
+
+func (k TupleKey) Encode(writer io.Writer) error { return EncodeTuple(k, writer) }
+
+func (k TupleKey) Bytes() []byte { return EncodedBytes(k) }
+
+// reverseKey is ordered by its Less method, in descending order
+type reverseKey U16
+
+func (k reverseKey) Encode(writer io.Writer) error { return U16(k).Encode(writer) }
+
+func (k reverseKey) Bytes() []byte { return U16(k).Bytes() }
+
+func (k reverseKey) Less(other reverseKey) bool { return k > other }
+
+func (k *reverseKey) Decode(reader io.Reader) error {
+	value, err := DecodeU16(reader)
+	*k = reverseKey(value)
+	return err
+}
+
+func Test_Dictionary_KeyOrder(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		decode func(reader io.Reader) (Encodable, error)
+		expect []byte
+	}{
+		{
+			label: "Dictionary[Bytes32, U8]",
+			input: Dictionary[Bytes32, U8]{{0x02}: 1, {0x01, 0xff}: 2},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[Bytes32, U8](reader)
+			},
+			expect: append(append(append([]byte{0x08, 0x01, 0xff}, make([]byte, 30)...), 0x02, 0x02), append(make([]byte, 31), 0x01)...),
+		},
+		{
+			label: "Dictionary[U128, U8]",
+			input: Dictionary[U128, U8]{NewU128(256): 1, NewU128(1): 2, MaxU128(): 3},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[U128, U8](reader)
+			},
+			expect: append(append(append(append(
+				[]byte{0x0c}, NewU128(1).Bytes()...), 0x02), append(NewU128(256).Bytes(), 0x01)...), append(MaxU128().Bytes(), 0x03)...),
+		},
+		{
+			label: "Dictionary[I64, U8]",
+			input: Dictionary[I64, U8]{1: 1, -1: 2},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[I64, U8](reader)
+			},
+			expect: []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x01, 0, 0, 0, 0, 0, 0, 0, 0x01},
+		},
+		{
+			label: "Dictionary[TupleKey, U8]",
+			input: Dictionary[TupleKey, U8]{{A: 2, B: "a"}: 1, {A: 1, B: "b"}: 2, {A: 1, B: "ab"}: 3},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[TupleKey, U8](reader)
+			},
+			expect: []byte{0x0c, 0x01, 0x08, 'a', 'b', 0x03, 0x01, 0x04, 'b', 0x02, 0x02, 0x04, 'a', 0x01},
+		},
+		{
+			label: "Dictionary[reverseKey, U8]",
+			input: Dictionary[reverseKey, U8]{1: 1, 2: 2},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[reverseKey, U8](reader)
+			},
+			expect: []byte{0x08, 0x02, 0x00, 0x02, 0x01, 0x00, 0x01},
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Equal(t, e.expect, e.input.Bytes())
+
+			result, err := e.decode(bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, result)
+		})
+	}
+}
+
+func Test_DecodeDictionary_Strict(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect Dictionary[U8, U8]
+	}{
+		{label: "Unsorted", input: []byte{0x08, 0x02, 0x01, 0x01, 0x02}, expect: Dictionary[U8, U8]{1: 2, 2: 1}},
+		{label: "Duplicate", input: []byte{0x08, 0x01, 0x01, 0x01, 0x02}, expect: Dictionary[U8, U8]{1: 2}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := DecodeDictionary[U8, U8](bytes.NewBuffer(e.input))
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+
+			_, err = DecodeDictionaryStrict[U8, U8](bytes.NewBuffer(e.input))
+			assert.Equal(t, errDictionaryNotCanonical, err)
+
+			_, err = DecodeDictionary[U8, U8](NewLimitedReader(bytes.NewBuffer(e.input), DecodeOptions{Strict: true}))
+			assert.Equal(t, errDictionaryNotCanonical, err)
+
+			_, err = DecodeBoundedDictionary[U8, U8, max2](NewLimitedReader(bytes.NewBuffer(e.input), DecodeOptions{Strict: true}))
+			assert.Equal(t, errDictionaryNotCanonical, err)
+		})
+	}
+
+	// the keys ordered by their encoding
+	input := append(append([]byte{0x08, 0x02}, make([]byte, 32)...), 0x01)
+	input = append(append(input, make([]byte, 31)...), 0x02)
+	_, err := DecodeDictionaryStrict[Bytes32, U8](bytes.NewBuffer(input))
+	assert.Equal(t, errDictionaryNotCanonical, err)
+}
+
+func Test_TupleDictionary_KeyOrder(t *testing.T) {
+	type tupleDictionary struct {
+		Tuple
+		A Dictionary[TupleKey, U8]
+		B Dictionary[Bytes32, U8]
+	}
+	input := tupleDictionary{
+		A: Dictionary[TupleKey, U8]{{A: 2, B: "a"}: 1, {A: 1, B: "b"}: 2},
+		B: Dictionary[Bytes32, U8]{{0x02}: 1, {0x01}: 2},
+	}
+	buffer := &bytes.Buffer{}
+
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, append(input.A.Bytes(), input.B.Bytes()...), buffer.Bytes())
+
+	result := tupleDictionary{}
+	err = DecodeTuple(&result, NewLimitedReader(bytes.NewBuffer(buffer.Bytes()), DecodeOptions{Strict: true}))
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	unsorted := []byte{0x08, 0x02, 0x04, 'a', 0x01, 0x01, 0x04, 'b', 0x02, 0x00}
+	err = DecodeTuple(&result, bytes.NewBuffer(unsorted))
+	assert.NoError(t, err)
+	err = DecodeTuple(&result, NewLimitedReader(bytes.NewBuffer(unsorted), DecodeOptions{Strict: true}))
+	assert.ErrorIs(t, err, errDictionaryNotCanonical)
+}
+
+// the fixtures are encoded by parity-scale-codec from BTreeMap and BTreeSet values
+func Test_Dictionary_RustOrder(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		decode func(reader io.Reader) (Encodable, error)
+		expect []byte
+	}{
+		{
+			label: "BTreeMap<Perbill, u8>",
+			input: Dictionary[Perbill, U8]{256: 2, 1: 1},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[Perbill, U8](reader)
+			},
+			expect: []byte{0x08, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x00, 0x02},
+		},
+		{
+			label: "BTreeMap<Option<u32>, u8>",
+			input: Dictionary[Option[U32], U8]{{true, 5}: 2, {true, 0}: 1, {}: 0},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[Option[U32], U8](reader)
+			},
+			expect: []byte{0x0c, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x05, 0x00, 0x00, 0x00, 0x02},
+		},
+		{
+			label: "BTreeMap<Compact<u32>, u8>",
+			input: Dictionary[CompactOf[U32], U8]{NewCompactOf(U32(64)): 2, NewCompactOf(U32(1)): 1},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[CompactOf[U32], U8](reader)
+			},
+			expect: []byte{0x08, 0x04, 0x01, 0x01, 0x01, 0x02},
+		},
+		{
+			label: "BTreeMap<FixedI64, u8>",
+			input: Dictionary[FixedI64, U8]{1: 2, -1: 1},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[FixedI64, U8](reader)
+			},
+			expect: []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02},
+		},
+		{
+			label: "BTreeMap<FixedU128, u8>",
+			input: Dictionary[FixedU128, U8]{FixedU128(NewU128(256)): 2, FixedU128(NewU128(1)): 1},
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeDictionaryStrict[FixedU128, U8](reader)
+			},
+			expect: append(append(append(append([]byte{0x08}, NewU128(1).Bytes()...), 0x01), NewU128(256).Bytes()...), 0x02),
+		},
+		{
+			label: "BTreeSet<Perquintill>",
+			input: NewSet[Perquintill](256, 1),
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeSetStrict[Perquintill](reader)
+			},
+			expect: []byte{0x08, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			label: "BTreeSet<Option<bool>>",
+			input: NewSet(OptionBool{true, true}, OptionBool{true, false}, OptionBool{}),
+			decode: func(reader io.Reader) (Encodable, error) {
+				return DecodeSetStrict[OptionBool](reader)
+			},
+			expect: []byte{0x0c, 0x00, 0x02, 0x01},
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Equal(t, e.expect, e.input.Bytes())
+
+			result, err := e.decode(bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, result)
+		})
+	}
+}
+
+func Test_Dictionary_KeyNotOrdered(t *testing.T) {
+	input := Dictionary[Enum[testCall], U8]{testPause.New(): 1}
+
+	err := input.Encode(&bytes.Buffer{})
+	assert.Equal(t, errKeyNotOrdered, err)
+
+	err = NewSet[Enum[testCall]](testPause.New()).Encode(&bytes.Buffer{})
+	assert.Equal(t, errKeyNotOrdered, err)
+
+	_, err = DecodeDictionaryStrict[Enum[testCall], U8](bytes.NewBuffer([]byte{0x04, 0x07, 0x01}))
+	assert.Equal(t, errKeyNotOrdered, err)
+
+	_, err = DecodeSetStrict[Enum[testCall]](bytes.NewBuffer([]byte{0x04, 0x07}))
+	assert.Equal(t, errKeyNotOrdered, err)
+
+	// the order is only needed to validate the keys in strict mode
+	result, err := DecodeDictionary[Enum[testCall], U8](bytes.NewBuffer([]byte{0x04, 0x07, 0x01}))
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	tuple := struct {
+		Tuple
+		A Dictionary[Enum[testCall], U8]
+	}{A: input}
+	err = EncodeTuple(tuple, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errTupleFieldNotSupported)
+}
@@ -7,12 +7,14 @@ package goscale
 	Values are encoded as byte array of zero length.
 */
 
-import "bytes"
+import (
+	"io"
+)
 
 type Empty struct{}
 
-func (e Empty) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (e Empty) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(e.Bytes())
 }
 
@@ -20,6 +22,18 @@ func (e Empty) Bytes() []byte {
 	return []byte{}
 }
 
+func (e Empty) EncodedLen() int {
+	return 0
+}
+
+func (e Empty) MaxEncodedLen() int {
+	return 0
+}
+
 func DecodeEmpty() (Empty, error) {
 	return Empty{}, nil
 }
+
+func (e *Empty) Decode(reader io.Reader) error {
+	return nil
+}
@@ -0,0 +1,300 @@
+package goscale
+
+/*
+	Ref: https://spec.polkadot.network/#defn-varying-data-type
+
+	Enum is a typed Varying Data Type, like a Rust enum. Its variants have names,
+	explicit indices (which can be sparse like `#[codec(index = N)]`) and typed payloads.
+	The variants are provided by an empty type, like the Bound of the bounded collections:
+
+	type Call struct{}
+
+	var (
+		Transfer = NewVariant[Call, TransferArgs]("Transfer", 0)
+		Remark   = NewVariant[Call, Sequence[U8]]("Remark", 1)
+		Pause    = NewUnitVariant[Call]("Pause", 7)
+	)
+
+	func (Call) Variants() []Variant { return []Variant{Transfer, Remark, Pause} }
+
+	An Enum[Call] is built with Transfer.New(args) or Pause.New(), its payload is
+	read back with Transfer.Payload(e) or dispatched with e.Match(...).
+*/
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+var (
+	errEnumNoVariant      = errors.New("enum has no variant")
+	errUnknownVariant     = errors.New("unknown enum variant")
+	errDuplicateVariant   = errors.New("duplicate enum variant index")
+	errNonExhaustiveMatch = errors.New("enum match does not cover all variants")
+)
+
+// EnumVariants provides the variants of an Enum, it is implemented by an empty type.
+type EnumVariants interface {
+	Variants() []Variant
+}
+
+// Variant is a variant of an Enum, built with NewVariant or NewUnitVariant.
+type Variant interface {
+	Name() string
+	Index() U8
+	decodePayload(reader io.Reader) (Encodable, error)
+	maxEncodedLen() (int, bool)
+}
+
+// VariantError reports the variant that could not be decoded.
+type VariantError struct {
+	Index U8
+	Name  string
+	Err   error
+}
+
+func (e *VariantError) Error() string {
+	if e.Name == "" {
+		return "variant " + strconv.Itoa(int(e.Index)) + ": " + e.Err.Error()
+	}
+	return "variant " + e.Name + " (" + strconv.Itoa(int(e.Index)) + "): " + e.Err.Error()
+}
+
+func (e *VariantError) Unwrap() error {
+	return e.Err
+}
+
+// VariantOf is a variant of Enum[D] with a payload of type T.
+type VariantOf[D EnumVariants, T Encodable] struct {
+	name  string
+	index U8
+}
+
+func NewVariant[D EnumVariants, T Encodable](name string, index U8) VariantOf[D, T] {
+	return VariantOf[D, T]{name: name, index: index}
+}
+
+func (v VariantOf[D, T]) Name() string {
+	return v.name
+}
+
+func (v VariantOf[D, T]) Index() U8 {
+	return v.index
+}
+
+// New returns the enum of this variant with payload.
+func (v VariantOf[D, T]) New(payload T) Enum[D] {
+	return Enum[D]{variant: v, payload: payload}
+}
+
+// Payload returns the payload of e, it is false if e is another variant.
+func (v VariantOf[D, T]) Payload(e Enum[D]) (T, bool) {
+	if !e.Is(v) {
+		return *new(T), false
+	}
+	return e.payload.(T), true
+}
+
+// Case handles this variant in Enum.Match.
+func (v VariantOf[D, T]) Case(handle func(payload T) error) Case[D] {
+	return Case[D]{variant: v, handle: func(payload Encodable) error { return handle(payload.(T)) }}
+}
+
+func (v VariantOf[D, T]) decodePayload(reader io.Reader) (Encodable, error) {
+	return decodeInto[T](reader)
+}
+
+func (v VariantOf[D, T]) maxEncodedLen() (int, bool) {
+	return MaxEncodedLen[T]()
+}
+
+// UnitVariant is a variant of Enum[D] without a payload.
+type UnitVariant[D EnumVariants] struct {
+	name  string
+	index U8
+}
+
+func NewUnitVariant[D EnumVariants](name string, index U8) UnitVariant[D] {
+	return UnitVariant[D]{name: name, index: index}
+}
+
+func (v UnitVariant[D]) Name() string {
+	return v.name
+}
+
+func (v UnitVariant[D]) Index() U8 {
+	return v.index
+}
+
+// New returns the enum of this variant.
+func (v UnitVariant[D]) New() Enum[D] {
+	return Enum[D]{variant: v, payload: Empty{}}
+}
+
+// Case handles this variant in Enum.Match.
+func (v UnitVariant[D]) Case(handle func() error) Case[D] {
+	return Case[D]{variant: v, handle: func(Encodable) error { return handle() }}
+}
+
+func (v UnitVariant[D]) decodePayload(reader io.Reader) (Encodable, error) {
+	return Empty{}, nil
+}
+
+func (v UnitVariant[D]) maxEncodedLen() (int, bool) {
+	return 0, true
+}
+
+// Case is a handler of a variant in Enum.Match.
+type Case[D EnumVariants] struct {
+	variant Variant
+	handle  func(payload Encodable) error
+}
+
+// Enum is a value of one of the variants of D. The zero value has no variant and can not be encoded.
+type Enum[D EnumVariants] struct {
+	variant Variant
+	payload Encodable
+}
+
+// Variant returns the variant of e, it is nil for the zero value.
+func (e Enum[D]) Variant() Variant {
+	return e.variant
+}
+
+// Index returns the index of the variant of e.
+func (e Enum[D]) Index() U8 {
+	if e.variant == nil {
+		return 0
+	}
+	return e.variant.Index()
+}
+
+// Payload returns the payload of e, it is Empty for the unit variants.
+func (e Enum[D]) Payload() Encodable {
+	return e.payload
+}
+
+// Is reports whether e is of variant v.
+func (e Enum[D]) Is(v Variant) bool {
+	return e.variant != nil && e.variant.Index() == v.Index() && e.variant.Name() == v.Name()
+}
+
+// Match calls the case of the variant of e. It fails if the cases do not cover all variants of D.
+func (e Enum[D]) Match(cases ...Case[D]) error {
+	for _, v := range (*new(D)).Variants() {
+		covered := false
+		for _, c := range cases {
+			if c.variant.Index() == v.Index() {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return &VariantError{Index: v.Index(), Name: v.Name(), Err: errNonExhaustiveMatch}
+		}
+	}
+
+	if e.variant == nil {
+		return errEnumNoVariant
+	}
+
+	for _, c := range cases {
+		if e.Is(c.variant) {
+			return c.handle(e.payload)
+		}
+	}
+	return &VariantError{Index: e.Index(), Name: e.variant.Name(), Err: errNonExhaustiveMatch}
+}
+
+func (e Enum[D]) Encode(writer io.Writer) error {
+	if e.variant == nil {
+		return errEnumNoVariant
+	}
+
+	err := e.variant.Index().Encode(writer)
+	if err != nil {
+		return err
+	}
+	return e.payload.Encode(writer)
+}
+
+func (e Enum[D]) Bytes() []byte {
+	return EncodedBytes(e)
+}
+
+func (e Enum[D]) EncodedLen() int {
+	if e.variant == nil {
+		return 0
+	}
+	return 1 + EncodedLen(e.payload)
+}
+
+func (e Enum[D]) maxEncodedLen() (int, bool) {
+	size := 0
+	for _, v := range (*new(D)).Variants() {
+		n, ok := v.maxEncodedLen()
+		if !ok {
+			return 0, false
+		}
+		size = max(size, n)
+	}
+	return 1 + size, true
+}
+
+// allows reflection based codecs to tell Enum[D] apart from Tuple
+func (e Enum[D]) enum() {}
+
+// DecodeEnum fails with a VariantError if the index is not a variant of D or its payload can not be decoded.
+func DecodeEnum[D EnumVariants](reader io.Reader) (Enum[D], error) {
+	index, err := DecodeU8(reader)
+	if err != nil {
+		return Enum[D]{}, err
+	}
+
+	variant, err := enumVariant[D](index)
+	if err != nil {
+		return Enum[D]{}, err
+	}
+
+	err = descend(reader)
+	if err != nil {
+		return Enum[D]{}, err
+	}
+	defer ascend(reader)
+
+	payload, err := variant.decodePayload(reader)
+	if err != nil {
+		err = Decoder{Reader: reader, Type: "Enum"}.Truncated(err)
+		return Enum[D]{}, &VariantError{Index: index, Name: variant.Name(), Err: err}
+	}
+	return Enum[D]{variant: variant, payload: payload}, nil
+}
+
+func (e *Enum[D]) Decode(reader io.Reader) error {
+	result, err := DecodeEnum[D](reader)
+	if err != nil {
+		return err
+	}
+	*e = result
+	return nil
+}
+
+// enumVariant returns the variant of D with index, the variants sharing it are rejected.
+func enumVariant[D EnumVariants](index U8) (Variant, error) {
+	var result Variant
+	for _, v := range (*new(D)).Variants() {
+		if v.Index() != index {
+			continue
+		}
+		if result != nil {
+			return nil, &VariantError{Index: index, Err: errDuplicateVariant}
+		}
+		result = v
+	}
+
+	if result == nil {
+		return nil, &VariantError{Index: index, Err: errUnknownVariant}
+	}
+	return result, nil
+}
@@ -0,0 +1,210 @@
+package goscale
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCall struct{}
+
+type TupleTransfer struct {
+	Tuple
+	To     Bytes32
+	Amount CompactOf[U128]
+}
+
+func (t TupleTransfer) Encode(writer io.Writer) error { return EncodeTuple(t, writer) }
+
+func (t TupleTransfer) Bytes() []byte { return EncodedBytes(t) }
+
+var (
+	testTransfer = NewVariant[testCall, TupleTransfer]("Transfer", 0)
+	testRemark   = NewVariant[testCall, Sequence[U8]]("Remark", 1)
+	testPause    = NewUnitVariant[testCall]("Pause", 7)
+)
+
+func (testCall) Variants() []Variant {
+	return []Variant{testTransfer, testRemark, testPause}
+}
+
+type testDuplicate struct{}
+
+func (testDuplicate) Variants() []Variant {
+	return []Variant{
+		NewUnitVariant[testDuplicate]("A", 1),
+		NewVariant[testDuplicate, U8]("B", 1),
+	}
+}
+
+func Test_Enum(t *testing.T) {
+	transfer := TupleTransfer{To: Bytes32{0x01}, Amount: NewCompactOf(NewU128(5))}
+
+	var examples = []struct {
+		label   string
+		input   Enum[testCall]
+		variant Variant
+		expect  []byte
+	}{
+		{
+			label:   "Transfer",
+			input:   testTransfer.New(transfer),
+			variant: testTransfer,
+			expect:  append(append([]byte{0x00, 0x01}, make([]byte, 31)...), 0x14),
+		},
+		{
+			label:   "Remark",
+			input:   testRemark.New(Sequence[U8]{0x2a}),
+			variant: testRemark,
+			expect:  []byte{0x01, 0x04, 0x2a},
+		},
+		{
+			label:   "Pause",
+			input:   testPause.New(),
+			variant: testPause,
+			expect:  []byte{0x07},
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Equal(t, e.variant.Index(), e.input.Index())
+			assert.Equal(t, e.variant, e.input.Variant())
+			assert.True(t, e.input.Is(e.variant))
+			assert.Equal(t, e.expect, e.input.Bytes())
+			assert.Equal(t, len(e.expect), e.input.EncodedLen())
+
+			result, err := DecodeEnum[testCall](bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, result)
+
+			var decoded Enum[testCall]
+			err = decoded.Decode(bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, decoded)
+		})
+	}
+}
+
+func Test_Enum_Payload(t *testing.T) {
+	e := testRemark.New(Sequence[U8]{1, 2})
+
+	remark, ok := testRemark.Payload(e)
+	assert.True(t, ok)
+	assert.Equal(t, Sequence[U8]{1, 2}, remark)
+	assert.Equal(t, Sequence[U8]{1, 2}, e.Payload())
+
+	transfer, ok := testTransfer.Payload(e)
+	assert.False(t, ok)
+	assert.Equal(t, TupleTransfer{}, transfer)
+
+	assert.Equal(t, Empty{}, testPause.New().Payload())
+}
+
+func Test_Enum_Match(t *testing.T) {
+	var matched string
+	cases := []Case[testCall]{
+		testTransfer.Case(func(payload TupleTransfer) error {
+			matched = "Transfer"
+			return nil
+		}),
+		testRemark.Case(func(payload Sequence[U8]) error {
+			matched = "Remark " + string(payload)
+			return nil
+		}),
+		testPause.Case(func() error {
+			return io.ErrUnexpectedEOF
+		}),
+	}
+
+	err := testRemark.New(Sequence[U8]("abc")).Match(cases...)
+	assert.NoError(t, err)
+	assert.Equal(t, "Remark abc", matched)
+
+	err = testTransfer.New(TupleTransfer{}).Match(cases...)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transfer", matched)
+
+	err = testPause.New().Match(cases...)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	err = testRemark.New(nil).Match(cases[:2]...)
+	assert.ErrorIs(t, err, errNonExhaustiveMatch)
+	assert.Equal(t, "variant Pause (7): "+errNonExhaustiveMatch.Error(), err.Error())
+
+	err = Enum[testCall]{}.Match(cases...)
+	assert.Equal(t, errEnumNoVariant, err)
+}
+
+func Test_Enum_Errors(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect error
+		index  U8
+	}{
+		{label: "Empty", input: []byte{}, expect: io.EOF},
+		{label: "Unknown variant", input: []byte{0x02}, expect: errUnknownVariant, index: 2},
+		{label: "Truncated payload", input: []byte{0x01, 0x08, 0x01}, expect: io.ErrUnexpectedEOF, index: 1},
+		{label: "Truncated tuple payload", input: []byte{0x00, 0x01}, expect: io.ErrUnexpectedEOF, index: 0},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			_, err := DecodeEnum[testCall](bytes.NewBuffer(e.input))
+
+			assert.ErrorIs(t, err, e.expect)
+			if variantErr, ok := err.(*VariantError); ok {
+				assert.Equal(t, e.index, variantErr.Index)
+			}
+		})
+	}
+
+	_, err := DecodeEnum[testDuplicate](bytes.NewBuffer([]byte{0x01}))
+	assert.ErrorIs(t, err, errDuplicateVariant)
+
+	err = Enum[testCall]{}.Encode(&bytes.Buffer{})
+	assert.Equal(t, errEnumNoVariant, err)
+}
+
+func Test_Enum_MaxEncodedLen(t *testing.T) {
+	size, ok := MaxEncodedLen[Enum[testCall]]()
+	assert.False(t, ok)
+	assert.Equal(t, 0, size)
+
+	size, ok = MaxEncodedLen[Enum[testDuplicate]]()
+	assert.True(t, ok)
+	assert.Equal(t, 2, size)
+}
+
+type TupleEnum struct {
+	Tuple
+	A Enum[testCall]
+	B Sequence[Enum[testCall]]
+	C Option[Enum[testCall]]
+}
+
+func Test_TupleEnum(t *testing.T) {
+	input := TupleEnum{
+		A: testPause.New(),
+		B: Sequence[Enum[testCall]]{testRemark.New(Sequence[U8]{1}), testPause.New()},
+		C: NewOption[Enum[testCall]](testRemark.New(Sequence[U8]{})),
+	}
+	expect := []byte{0x07, 0x08, 0x01, 0x04, 0x01, 0x07, 0x01, 0x01, 0x00}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	size, err := TupleEncodedLen(input)
+	assert.NoError(t, err)
+	assert.Equal(t, len(expect), size)
+
+	result := TupleEnum{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
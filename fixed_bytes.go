@@ -0,0 +1,274 @@
+package goscale
+
+/*
+	Fixed size byte arrays, like hashes, public keys and signatures,
+	are encoded as is without boxing each byte as U8.
+*/
+
+import (
+	"io"
+)
+
+type Bytes16 [16]byte
+
+// NewBytes16 fails if the length of b is not 16.
+func NewBytes16(b []byte) (Bytes16, error) {
+	if len(b) != 16 {
+		return Bytes16{}, errFixedSequenceLength
+	}
+	return Bytes16(b), nil
+}
+
+func (b Bytes16) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes16) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes16) EncodedLen() int {
+	return 16
+}
+
+func (b Bytes16) MaxEncodedLen() int {
+	return 16
+}
+
+func DecodeBytes16(reader io.Reader) (Bytes16, error) {
+	var result Bytes16
+	err := Decoder{Reader: reader, Type: "Bytes16"}.Read(result[:])
+	if err != nil {
+		return Bytes16{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes16) Decode(reader io.Reader) error {
+	result, err := DecodeBytes16(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+type Bytes20 [20]byte
+
+// NewBytes20 fails if the length of b is not 20.
+func NewBytes20(b []byte) (Bytes20, error) {
+	if len(b) != 20 {
+		return Bytes20{}, errFixedSequenceLength
+	}
+	return Bytes20(b), nil
+}
+
+func (b Bytes20) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes20) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes20) EncodedLen() int {
+	return 20
+}
+
+func (b Bytes20) MaxEncodedLen() int {
+	return 20
+}
+
+func DecodeBytes20(reader io.Reader) (Bytes20, error) {
+	var result Bytes20
+	err := Decoder{Reader: reader, Type: "Bytes20"}.Read(result[:])
+	if err != nil {
+		return Bytes20{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes20) Decode(reader io.Reader) error {
+	result, err := DecodeBytes20(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+type Bytes32 [32]byte
+
+// NewBytes32 fails if the length of b is not 32.
+func NewBytes32(b []byte) (Bytes32, error) {
+	if len(b) != 32 {
+		return Bytes32{}, errFixedSequenceLength
+	}
+	return Bytes32(b), nil
+}
+
+func (b Bytes32) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes32) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes32) EncodedLen() int {
+	return 32
+}
+
+func (b Bytes32) MaxEncodedLen() int {
+	return 32
+}
+
+func DecodeBytes32(reader io.Reader) (Bytes32, error) {
+	var result Bytes32
+	err := Decoder{Reader: reader, Type: "Bytes32"}.Read(result[:])
+	if err != nil {
+		return Bytes32{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes32) Decode(reader io.Reader) error {
+	result, err := DecodeBytes32(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+type Bytes33 [33]byte
+
+// NewBytes33 fails if the length of b is not 33.
+func NewBytes33(b []byte) (Bytes33, error) {
+	if len(b) != 33 {
+		return Bytes33{}, errFixedSequenceLength
+	}
+	return Bytes33(b), nil
+}
+
+func (b Bytes33) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes33) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes33) EncodedLen() int {
+	return 33
+}
+
+func (b Bytes33) MaxEncodedLen() int {
+	return 33
+}
+
+func DecodeBytes33(reader io.Reader) (Bytes33, error) {
+	var result Bytes33
+	err := Decoder{Reader: reader, Type: "Bytes33"}.Read(result[:])
+	if err != nil {
+		return Bytes33{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes33) Decode(reader io.Reader) error {
+	result, err := DecodeBytes33(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+type Bytes64 [64]byte
+
+// NewBytes64 fails if the length of b is not 64.
+func NewBytes64(b []byte) (Bytes64, error) {
+	if len(b) != 64 {
+		return Bytes64{}, errFixedSequenceLength
+	}
+	return Bytes64(b), nil
+}
+
+func (b Bytes64) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes64) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes64) EncodedLen() int {
+	return 64
+}
+
+func (b Bytes64) MaxEncodedLen() int {
+	return 64
+}
+
+func DecodeBytes64(reader io.Reader) (Bytes64, error) {
+	var result Bytes64
+	err := Decoder{Reader: reader, Type: "Bytes64"}.Read(result[:])
+	if err != nil {
+		return Bytes64{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes64) Decode(reader io.Reader) error {
+	result, err := DecodeBytes64(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+type Bytes65 [65]byte
+
+// NewBytes65 fails if the length of b is not 65.
+func NewBytes65(b []byte) (Bytes65, error) {
+	if len(b) != 65 {
+		return Bytes65{}, errFixedSequenceLength
+	}
+	return Bytes65(b), nil
+}
+
+func (b Bytes65) Encode(writer io.Writer) error {
+	return Encoder{Writer: writer}.Write(b[:])
+}
+
+func (b Bytes65) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
+
+func (b Bytes65) EncodedLen() int {
+	return 65
+}
+
+func (b Bytes65) MaxEncodedLen() int {
+	return 65
+}
+
+func DecodeBytes65(reader io.Reader) (Bytes65, error) {
+	var result Bytes65
+	err := Decoder{Reader: reader, Type: "Bytes65"}.Read(result[:])
+	if err != nil {
+		return Bytes65{}, err
+	}
+	return result, nil
+}
+
+func (b *Bytes65) Decode(reader io.Reader) error {
+	result, err := DecodeBytes65(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
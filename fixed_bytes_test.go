@@ -0,0 +1,92 @@
+package goscale
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FixedBytes_Encode(t *testing.T) {
+	hash := Bytes32{}
+	for i := range hash {
+		hash[i] = byte(i)
+	}
+
+	var examples = []struct {
+		label  string
+		input  Encodable
+		expect []byte
+	}{
+		{label: "Encode Bytes16", input: Bytes16{0xff, 15: 0x01}, expect: append([]byte{0xff}, append(make([]byte, 14), 0x01)...)},
+		{label: "Encode Bytes20", input: Bytes20{}, expect: make([]byte, 20)},
+		{label: "Encode Bytes32", input: hash, expect: hash[:]},
+		{label: "Encode Bytes33", input: Bytes33{0x02}, expect: append([]byte{0x02}, make([]byte, 32)...)},
+		{label: "Encode Bytes64", input: Bytes64{63: 0xaa}, expect: append(make([]byte, 63), 0xaa)},
+		{label: "Encode Bytes65", input: Bytes65{64: 0x1b}, expect: append(make([]byte, 64), 0x1b)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+		})
+	}
+}
+
+func Test_FixedBytes_Bytes_Copies(t *testing.T) {
+	hash := Bytes32{1}
+
+	hash.Bytes()[0] = 2
+
+	assert.Equal(t, Bytes32{1}, hash)
+}
+
+func Test_FixedBytes_Decode(t *testing.T) {
+	input := make([]byte, 65)
+	for i := range input {
+		input[i] = byte(i)
+	}
+
+	hash, err := DecodeBytes32(bytes.NewBuffer(input))
+	assert.NoError(t, err)
+	assert.Equal(t, input[:32], hash[:])
+
+	var signature Bytes65
+	err = signature.Decode(bytes.NewBuffer(input))
+	assert.NoError(t, err)
+	assert.Equal(t, input, signature[:])
+
+	address, err := DecodeBytes20(bytes.NewBuffer(input))
+	assert.NoError(t, err)
+	assert.Equal(t, input[:20], address[:])
+
+	_, err = DecodeBytes64(bytes.NewBuffer(input[:63]))
+	var decodeErr *DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, "Bytes64", decodeErr.Type)
+	assert.Equal(t, 63, decodeErr.Read)
+	assert.Equal(t, 64, decodeErr.Expected)
+
+	hashes, err := DecodeSequence[Bytes16](bytes.NewBuffer(append([]byte{0x04}, input[:16]...)))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[Bytes16]{Bytes16(input[:16])}, hashes)
+}
+
+func Test_NewFixedBytes(t *testing.T) {
+	hash, err := NewBytes32(make([]byte, 32))
+	assert.NoError(t, err)
+	assert.Equal(t, Bytes32{}, hash)
+
+	_, err = NewBytes32(make([]byte, 31))
+	assert.Equal(t, errFixedSequenceLength, err)
+
+	_, err = NewBytes33(make([]byte, 32))
+	assert.Equal(t, errFixedSequenceLength, err)
+}
@@ -0,0 +1,529 @@
+package goscale
+
+/*
+	Ref: https://docs.rs/sp-arithmetic/latest/sp_arithmetic/fixed_point/index.html
+
+	FixedU128, FixedI64 and FixedI128 are decimal fixed-point numbers, their inner
+	integers are scaled by 10^18, 10^9 and 10^18 respectively and encoded as is.
+	Like in Substrate, the multiplication and the division round towards zero.
+*/
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const (
+	FixedU128Accuracy = 1_000_000_000_000_000_000
+	FixedI64Accuracy  = 1_000_000_000
+	FixedI128Accuracy = 1_000_000_000_000_000_000
+)
+
+var (
+	errNegativeSqrt      = errors.New("square root of a negative number")
+	errInvalidFixedPoint = errors.New("invalid fixed point number")
+)
+
+// FixedPoint is implemented by FixedU128, FixedI64 and FixedI128, which share the method set:
+// the conversions fail if the result is out of range, FromRational and the multiplication and
+// the division round towards zero, String formats all fractional digits like Substrate does,
+// the saturating operations clamp to the bounds (dividing by zero saturates towards the sign
+// of the dividend) and Sqrt rounds down, it fails for the negative numbers.
+type FixedPoint interface {
+	FixedU128 | FixedI64 | FixedI128
+}
+
+type FixedU128 U128
+
+func FixedU128FromInner(inner U128) FixedU128 {
+	return FixedU128(inner)
+}
+
+func FixedU128FromInteger[I Integer](n I) (FixedU128, error) {
+	return fixedFromInteger[FixedU128](n)
+}
+
+func FixedU128FromRational[I Integer](n, d I) (FixedU128, error) {
+	return fixedFromRational[FixedU128](n, d)
+}
+
+// ParseFixedU128 parses a non-negative decimal number with at most 18 fractional digits, e.g. "1.25".
+func ParseFixedU128(s string) (FixedU128, error) {
+	return parseFixedPoint[FixedU128](s)
+}
+
+func (f FixedU128) Inner() U128 {
+	return U128(f)
+}
+
+func (f FixedU128) String() string {
+	return formatFixedPoint(f)
+}
+
+func (f FixedU128) ToBigRat() *big.Rat {
+	return fixedToBigRat(f)
+}
+
+func (f FixedU128) CheckedAdd(other FixedU128) (FixedU128, error) {
+	return fixedCheckedAdd(f, other)
+}
+
+func (f FixedU128) CheckedSub(other FixedU128) (FixedU128, error) {
+	return fixedCheckedSub(f, other)
+}
+
+func (f FixedU128) CheckedMul(other FixedU128) (FixedU128, error) {
+	return fixedCheckedMul(f, other)
+}
+
+func (f FixedU128) CheckedDiv(other FixedU128) (FixedU128, error) {
+	return fixedCheckedDiv(f, other)
+}
+
+func (f FixedU128) SaturatingAdd(other FixedU128) FixedU128 {
+	return fixedSaturate(fixedCheckedAdd(f, other))
+}
+
+func (f FixedU128) SaturatingSub(other FixedU128) FixedU128 {
+	return fixedSaturate(fixedCheckedSub(f, other))
+}
+
+func (f FixedU128) SaturatingMul(other FixedU128) FixedU128 {
+	return fixedSaturate(fixedCheckedMul(f, other))
+}
+
+func (f FixedU128) SaturatingDiv(other FixedU128) FixedU128 {
+	return fixedSaturatingDiv(f, other)
+}
+
+func (f FixedU128) Sqrt() (FixedU128, error) {
+	return fixedSqrt(f)
+}
+
+func (f FixedU128) Encode(writer io.Writer) error {
+	return U128(f).Encode(writer)
+}
+
+func (f FixedU128) Bytes() []byte {
+	return U128(f).Bytes()
+}
+
+func (f FixedU128) EncodedLen() int {
+	return 16
+}
+
+func (f FixedU128) MaxEncodedLen() int {
+	return 16
+}
+
+func DecodeFixedU128(reader io.Reader) (FixedU128, error) {
+	inner, err := DecodeU128(reader)
+	return FixedU128(inner), err
+}
+
+func (f *FixedU128) Decode(reader io.Reader) error {
+	result, err := DecodeFixedU128(reader)
+	if err != nil {
+		return err
+	}
+	*f = result
+	return nil
+}
+
+type FixedI64 I64
+
+func FixedI64FromInner(inner I64) FixedI64 {
+	return FixedI64(inner)
+}
+
+func FixedI64FromInteger[I Integer](n I) (FixedI64, error) {
+	return fixedFromInteger[FixedI64](n)
+}
+
+func FixedI64FromRational[I Integer](n, d I) (FixedI64, error) {
+	return fixedFromRational[FixedI64](n, d)
+}
+
+// ParseFixedI64 parses a decimal number with at most 9 fractional digits, e.g. "-1.25".
+func ParseFixedI64(s string) (FixedI64, error) {
+	return parseFixedPoint[FixedI64](s)
+}
+
+func (f FixedI64) Inner() I64 {
+	return I64(f)
+}
+
+func (f FixedI64) String() string {
+	return formatFixedPoint(f)
+}
+
+func (f FixedI64) ToBigRat() *big.Rat {
+	return fixedToBigRat(f)
+}
+
+func (f FixedI64) CheckedAdd(other FixedI64) (FixedI64, error) {
+	return fixedCheckedAdd(f, other)
+}
+
+func (f FixedI64) CheckedSub(other FixedI64) (FixedI64, error) {
+	return fixedCheckedSub(f, other)
+}
+
+func (f FixedI64) CheckedMul(other FixedI64) (FixedI64, error) {
+	return fixedCheckedMul(f, other)
+}
+
+func (f FixedI64) CheckedDiv(other FixedI64) (FixedI64, error) {
+	return fixedCheckedDiv(f, other)
+}
+
+func (f FixedI64) SaturatingAdd(other FixedI64) FixedI64 {
+	return fixedSaturate(fixedCheckedAdd(f, other))
+}
+
+func (f FixedI64) SaturatingSub(other FixedI64) FixedI64 {
+	return fixedSaturate(fixedCheckedSub(f, other))
+}
+
+func (f FixedI64) SaturatingMul(other FixedI64) FixedI64 {
+	return fixedSaturate(fixedCheckedMul(f, other))
+}
+
+func (f FixedI64) SaturatingDiv(other FixedI64) FixedI64 {
+	return fixedSaturatingDiv(f, other)
+}
+
+func (f FixedI64) Sqrt() (FixedI64, error) {
+	return fixedSqrt(f)
+}
+
+func (f FixedI64) Encode(writer io.Writer) error {
+	return I64(f).Encode(writer)
+}
+
+func (f FixedI64) Bytes() []byte {
+	return I64(f).Bytes()
+}
+
+func (f FixedI64) EncodedLen() int {
+	return 8
+}
+
+func (f FixedI64) MaxEncodedLen() int {
+	return 8
+}
+
+func DecodeFixedI64(reader io.Reader) (FixedI64, error) {
+	inner, err := DecodeI64(reader)
+	return FixedI64(inner), err
+}
+
+func (f *FixedI64) Decode(reader io.Reader) error {
+	result, err := DecodeFixedI64(reader)
+	if err != nil {
+		return err
+	}
+	*f = result
+	return nil
+}
+
+type FixedI128 I128
+
+func FixedI128FromInner(inner I128) FixedI128 {
+	return FixedI128(inner)
+}
+
+func FixedI128FromInteger[I Integer](n I) (FixedI128, error) {
+	return fixedFromInteger[FixedI128](n)
+}
+
+func FixedI128FromRational[I Integer](n, d I) (FixedI128, error) {
+	return fixedFromRational[FixedI128](n, d)
+}
+
+// ParseFixedI128 parses a decimal number with at most 18 fractional digits, e.g. "-1.25".
+func ParseFixedI128(s string) (FixedI128, error) {
+	return parseFixedPoint[FixedI128](s)
+}
+
+func (f FixedI128) Inner() I128 {
+	return I128(f)
+}
+
+func (f FixedI128) String() string {
+	return formatFixedPoint(f)
+}
+
+func (f FixedI128) ToBigRat() *big.Rat {
+	return fixedToBigRat(f)
+}
+
+func (f FixedI128) CheckedAdd(other FixedI128) (FixedI128, error) {
+	return fixedCheckedAdd(f, other)
+}
+
+func (f FixedI128) CheckedSub(other FixedI128) (FixedI128, error) {
+	return fixedCheckedSub(f, other)
+}
+
+func (f FixedI128) CheckedMul(other FixedI128) (FixedI128, error) {
+	return fixedCheckedMul(f, other)
+}
+
+func (f FixedI128) CheckedDiv(other FixedI128) (FixedI128, error) {
+	return fixedCheckedDiv(f, other)
+}
+
+func (f FixedI128) SaturatingAdd(other FixedI128) FixedI128 {
+	return fixedSaturate(fixedCheckedAdd(f, other))
+}
+
+func (f FixedI128) SaturatingSub(other FixedI128) FixedI128 {
+	return fixedSaturate(fixedCheckedSub(f, other))
+}
+
+func (f FixedI128) SaturatingMul(other FixedI128) FixedI128 {
+	return fixedSaturate(fixedCheckedMul(f, other))
+}
+
+func (f FixedI128) SaturatingDiv(other FixedI128) FixedI128 {
+	return fixedSaturatingDiv(f, other)
+}
+
+func (f FixedI128) Sqrt() (FixedI128, error) {
+	return fixedSqrt(f)
+}
+
+func (f FixedI128) Encode(writer io.Writer) error {
+	return I128(f).Encode(writer)
+}
+
+func (f FixedI128) Bytes() []byte {
+	return I128(f).Bytes()
+}
+
+func (f FixedI128) EncodedLen() int {
+	return 16
+}
+
+func (f FixedI128) MaxEncodedLen() int {
+	return 16
+}
+
+func DecodeFixedI128(reader io.Reader) (FixedI128, error) {
+	inner, err := DecodeI128(reader)
+	return FixedI128(inner), err
+}
+
+func (f *FixedI128) Decode(reader io.Reader) error {
+	result, err := DecodeFixedI128(reader)
+	if err != nil {
+		return err
+	}
+	*f = result
+	return nil
+}
+
+func fixedAccuracy[F FixedPoint]() *big.Int {
+	switch any(*new(F)).(type) {
+	case FixedI64:
+		return big.NewInt(FixedI64Accuracy)
+	case FixedI128:
+		return big.NewInt(FixedI128Accuracy)
+	default:
+		return big.NewInt(FixedU128Accuracy)
+	}
+}
+
+// fixedPrecision is the number of the fractional digits of F.
+func fixedPrecision[F FixedPoint]() int {
+	if _, ok := any(*new(F)).(FixedI64); ok {
+		return 9
+	}
+	return 18
+}
+
+func fixedToBigInt[F FixedPoint](f F) *big.Int {
+	switch f := any(f).(type) {
+	case FixedI64:
+		return big.NewInt(int64(f))
+	case FixedI128:
+		return I128(f).ToBigInt()
+	default:
+		return U128(any(f).(FixedU128)).ToBigInt()
+	}
+}
+
+// fixedFromBigInt converts the inner value, failing if it is out of the range of F.
+func fixedFromBigInt[F FixedPoint](inner *big.Int) (F, error) {
+	var result F
+	var min, max *big.Int
+	switch any(result).(type) {
+	case FixedI64:
+		min, max = big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)
+	case FixedI128:
+		min, max = MinI128().ToBigInt(), MaxI128().ToBigInt()
+	default:
+		min, max = big.NewInt(0), MaxU128().ToBigInt()
+	}
+	if inner.Cmp(max) > 0 {
+		return result, errOverflow
+	}
+	if inner.Cmp(min) < 0 {
+		return result, errUnderflow
+	}
+
+	switch r := any(&result).(type) {
+	case *FixedI64:
+		*r = FixedI64(inner.Int64())
+	case *FixedI128:
+		*r = FixedI128(bigIntToI128(inner))
+	case *FixedU128:
+		*r = FixedU128(bigIntToU128(inner))
+	}
+	return result, nil
+}
+
+func fixedFromInteger[F FixedPoint, I Integer](n I) (F, error) {
+	bn, _ := integerToBigInt(n)
+	return fixedFromBigInt[F](new(big.Int).Mul(bn, fixedAccuracy[F]()))
+}
+
+func fixedFromRational[F FixedPoint, I Integer](n, d I) (F, error) {
+	numerator, _ := integerToBigInt(n)
+	denominator, _ := integerToBigInt(d)
+	return fixedQuo[F](numerator, fixedAccuracy[F](), denominator)
+}
+
+// fixedToBigRat returns the exact value of f.
+func fixedToBigRat[F FixedPoint](f F) *big.Rat {
+	return new(big.Rat).SetFrac(fixedToBigInt(f), fixedAccuracy[F]())
+}
+
+func fixedCheckedAdd[F FixedPoint](a, b F) (F, error) {
+	return fixedFromBigInt[F](new(big.Int).Add(fixedToBigInt(a), fixedToBigInt(b)))
+}
+
+func fixedCheckedSub[F FixedPoint](a, b F) (F, error) {
+	return fixedFromBigInt[F](new(big.Int).Sub(fixedToBigInt(a), fixedToBigInt(b)))
+}
+
+func fixedCheckedMul[F FixedPoint](a, b F) (F, error) {
+	return fixedQuo[F](fixedToBigInt(a), fixedToBigInt(b), fixedAccuracy[F]())
+}
+
+func fixedCheckedDiv[F FixedPoint](a, b F) (F, error) {
+	return fixedQuo[F](fixedToBigInt(a), fixedAccuracy[F](), fixedToBigInt(b))
+}
+
+// fixedQuo returns the inner value of a * b / c rounded towards zero.
+func fixedQuo[F FixedPoint](a, b, c *big.Int) (F, error) {
+	if c.Sign() == 0 {
+		return *new(F), errDivisionByZero
+	}
+	product := new(big.Int).Mul(a, b)
+	return fixedFromBigInt[F](product.Quo(product, c))
+}
+
+// fixedSaturate clamps the result of a checked operation to the bounds of F.
+func fixedSaturate[F FixedPoint](value F, err error) F {
+	if err != errOverflow && err != errUnderflow {
+		return value
+	}
+	max := err == errOverflow
+	var result any
+	switch any(value).(type) {
+	case FixedI64:
+		result = FixedI64(math.MinInt64)
+		if max {
+			result = FixedI64(math.MaxInt64)
+		}
+	case FixedI128:
+		result = FixedI128(MinI128())
+		if max {
+			result = FixedI128(MaxI128())
+		}
+	default:
+		result = FixedU128{}
+		if max {
+			result = FixedU128(MaxU128())
+		}
+	}
+	return result.(F)
+}
+
+// fixedSaturatingDiv returns f / other clamped to the bounds of F, dividing by zero
+// saturates towards the sign of f.
+func fixedSaturatingDiv[F FixedPoint](f, other F) F {
+	dividend := fixedToBigInt(f)
+	result, err := fixedQuo[F](dividend, fixedAccuracy[F](), fixedToBigInt(other))
+	if err == errDivisionByZero {
+		switch dividend.Sign() {
+		case 1:
+			err = errOverflow
+		case -1:
+			err = errUnderflow
+		default:
+			return result
+		}
+	}
+	return fixedSaturate(result, err)
+}
+
+func fixedSqrt[F FixedPoint](f F) (F, error) {
+	inner := fixedToBigInt(f)
+	if inner.Sign() < 0 {
+		return *new(F), errNegativeSqrt
+	}
+	// sqrt(inner / accuracy) * accuracy = sqrt(inner * accuracy)
+	inner.Mul(inner, fixedAccuracy[F]())
+	return fixedFromBigInt[F](inner.Sqrt(inner))
+}
+
+func formatFixedPoint[F FixedPoint](f F) string {
+	inner, accuracy, precision := fixedToBigInt(f), fixedAccuracy[F](), fixedPrecision[F]()
+	sign := ""
+	if inner.Sign() < 0 {
+		sign = "-"
+	}
+	integral, fractional := new(big.Int).QuoRem(new(big.Int).Abs(inner), accuracy, new(big.Int))
+
+	digits := fractional.String()
+	return sign + integral.String() + "." + strings.Repeat("0", precision-len(digits)) + digits
+}
+
+// parseFixedPoint fails if s has more fractional digits than F, or a sign other than + for FixedU128.
+func parseFixedPoint[F FixedPoint](s string) (F, error) {
+	precision := fixedPrecision[F]()
+	negative := strings.HasPrefix(s, "-")
+	if _, unsigned := any(*new(F)).(FixedU128); negative && unsigned {
+		return *new(F), errInvalidFixedPoint
+	}
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	integral, fractional, hasPoint := strings.Cut(s, ".")
+	if integral == "" || (hasPoint && fractional == "") || len(fractional) > precision ||
+		!isDecimalDigits(integral) || !isDecimalDigits(fractional) {
+		return *new(F), errInvalidFixedPoint
+	}
+
+	inner, _ := new(big.Int).SetString(integral+fractional+strings.Repeat("0", precision-len(fractional)), 10)
+	if negative {
+		inner.Neg(inner)
+	}
+	return fixedFromBigInt[F](inner)
+}
+
+func isDecimalDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+package goscale
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FixedPoint_Encode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		expect []byte
+	}{
+		{label: "Encode FixedU128(1.5)", input: FixedU128FromInner(NewU128(1_500_000_000_000_000_000)), expect: []byte{0x00, 0x00, 0x16, 0x7b, 0x0d, 0x12, 0xd1, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{label: "Encode FixedI64(-1)", input: FixedI64FromInner(-1_000_000_000), expect: []byte{0x00, 0x36, 0x65, 0xc4, 0xff, 0xff, 0xff, 0xff}},
+		{label: "Encode FixedI128(-2.5)", input: FixedI128FromInner(NewI128(-2_500_000_000_000_000_000)), expect: []byte{0x00, 0x00, 0x86, 0xdd, 0x3e, 0x37, 0x4e, 0xdd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+		})
+	}
+}
+
+func Test_FixedPoint_Decode(t *testing.T) {
+	fixedU128, err := DecodeFixedU128(bytes.NewBuffer([]byte{0x00, 0x00, 0x16, 0x7b, 0x0d, 0x12, 0xd1, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.500000000000000000", fixedU128.String())
+
+	var fixedI64 FixedI64
+	err = fixedI64.Decode(bytes.NewBuffer([]byte{0x00, 0x36, 0x65, 0xc4, 0xff, 0xff, 0xff, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64(-1_000_000_000), fixedI64)
+
+	fixedI128, err := DecodeFixedI128(bytes.NewBuffer([]byte{0x00, 0x00, 0x86, 0xdd, 0x3e, 0x37, 0x4e, 0xdd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, "-2.500000000000000000", fixedI128.String())
+
+	_, err = DecodeFixedI128(bytes.NewBuffer([]byte{0x01}))
+	assert.Error(t, err)
+
+	sequence, err := DecodeSequence[FixedI64](bytes.NewBuffer([]byte{0x04, 0x00, 0x36, 0x65, 0xc4, 0xff, 0xff, 0xff, 0xff}))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[FixedI64]{-1_000_000_000}, sequence)
+}
+
+func Test_FixedPoint_FromInteger(t *testing.T) {
+	fixedI64, err := FixedI64FromInteger(-3)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64(-3_000_000_000), fixedI64)
+
+	fixedU128, err := FixedU128FromInteger(NewU64(math.MaxUint64))
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073709551615.000000000000000000", fixedU128.String())
+
+	_, err = FixedI64FromInteger(int64(10_000_000_000))
+	assert.Equal(t, errOverflow, err)
+
+	_, err = FixedU128FromInteger(-1)
+	assert.Equal(t, errUnderflow, err)
+
+	_, err = FixedI128FromInteger(MaxI128())
+	assert.Equal(t, errOverflow, err)
+
+	n := big.NewInt(7)
+	fixedI128, err := FixedI128FromInteger(n)
+	assert.NoError(t, err)
+	assert.Equal(t, "7.000000000000000000", fixedI128.String())
+	assert.Equal(t, big.NewInt(7), n)
+}
+
+func Test_FixedPoint_FromRational(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		result FixedI64
+		err    error
+		expect FixedI64
+	}{
+		{label: "1/3", expect: 333_333_333},
+		{label: "-1/3", expect: -333_333_333},
+		{label: "7/-2", expect: -3_500_000_000},
+		{label: "1/0"},
+	}
+	testExamples[0].result, testExamples[0].err = FixedI64FromRational(1, 3)
+	testExamples[1].result, testExamples[1].err = FixedI64FromRational(-1, 3)
+	testExamples[2].result, testExamples[2].err = FixedI64FromRational(7, -2)
+	testExamples[3].result, testExamples[3].err = FixedI64FromRational(1, 0)
+
+	for _, testExample := range testExamples[:3] {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.NoError(t, testExample.err)
+			assert.Equal(t, testExample.expect, testExample.result)
+		})
+	}
+	assert.Equal(t, errDivisionByZero, testExamples[3].err)
+
+	fixedU128, err := FixedU128FromRational(NewU128(2), NewU128(3))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.666666666666666666", fixedU128.String())
+
+	_, err = FixedU128FromRational(-1, 2)
+	assert.Equal(t, errUnderflow, err)
+}
+
+func Test_FixedPoint_Checked(t *testing.T) {
+	oneAndHalf, _ := ParseFixedI128("1.5")
+	minusTwo, _ := FixedI128FromInteger(-2)
+
+	result, err := oneAndHalf.CheckedAdd(minusTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.500000000000000000", result.String())
+
+	result, err = oneAndHalf.CheckedSub(minusTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, "3.500000000000000000", result.String())
+
+	result, err = oneAndHalf.CheckedMul(minusTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, "-3.000000000000000000", result.String())
+
+	result, err = oneAndHalf.CheckedDiv(minusTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.750000000000000000", result.String())
+
+	// rounds towards zero
+	product, err := FixedI64(-1).CheckedMul(FixedI64(500_000_000))
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64(0), product)
+
+	quotient, err := FixedI64(-1_000_000_000).CheckedDiv(FixedI64(3_000_000_000))
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64(-333_333_333), quotient)
+
+	_, err = oneAndHalf.CheckedDiv(FixedI128{})
+	assert.Equal(t, errDivisionByZero, err)
+
+	_, err = FixedI64(math.MaxInt64).CheckedAdd(1)
+	assert.Equal(t, errOverflow, err)
+
+	_, err = FixedU128{}.CheckedSub(FixedU128FromInner(NewU128(1)))
+	assert.Equal(t, errUnderflow, err)
+
+	_, err = FixedI128(MaxI128()).CheckedMul(FixedI128(NewI128(2_000_000_000_000_000_000)))
+	assert.Equal(t, errOverflow, err)
+}
+
+func Test_FixedPoint_Saturating(t *testing.T) {
+	assert.Equal(t, FixedI64(math.MaxInt64), FixedI64(math.MaxInt64).SaturatingAdd(1))
+	assert.Equal(t, FixedI64(math.MinInt64), FixedI64(math.MinInt64).SaturatingSub(1))
+	assert.Equal(t, FixedI64(3_000_000_000), FixedI64(1_000_000_000).SaturatingAdd(2_000_000_000))
+	assert.Equal(t, FixedU128{}, FixedU128{}.SaturatingSub(FixedU128FromInner(NewU128(1))))
+	assert.Equal(t, FixedI128(MinI128()), FixedI128(MaxI128()).SaturatingMul(FixedI128(NewI128(-2_000_000_000_000_000_000))))
+	assert.Equal(t, FixedU128(MaxU128()), FixedU128(MaxU128()).SaturatingDiv(FixedU128FromInner(NewU128(1))))
+	assert.Equal(t, FixedI64(-5_000_000_000), FixedI64(-2_500_000_000).SaturatingMul(2_000_000_000))
+
+	assert.Equal(t, FixedI64(math.MaxInt64), FixedI64(1).SaturatingDiv(0))
+	assert.Equal(t, FixedI128(MinI128()), FixedI128(NewI128(-1)).SaturatingDiv(FixedI128{}))
+	assert.Equal(t, FixedU128(MaxU128()), FixedU128FromInner(NewU128(1)).SaturatingDiv(FixedU128{}))
+	assert.Equal(t, FixedI64(0), FixedI64(0).SaturatingDiv(0))
+}
+
+func Test_FixedPoint_Sqrt(t *testing.T) {
+	four, _ := FixedU128FromInteger(4)
+	result, err := four.Sqrt()
+	assert.NoError(t, err)
+	assert.Equal(t, "2.000000000000000000", result.String())
+
+	two, _ := FixedI64FromInteger(2)
+	sqrtTwo, err := two.Sqrt()
+	assert.NoError(t, err)
+	assert.Equal(t, FixedI64(1_414_213_562), sqrtTwo)
+
+	max, err := FixedU128(MaxU128()).Sqrt()
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073.709551615999999999", max.String())
+
+	_, err = FixedI128(NewI128(-1)).Sqrt()
+	assert.Equal(t, errNegativeSqrt, err)
+}
+
+func Test_FixedPoint_String(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		input  interface{ String() string }
+		expect string
+	}{
+		{label: "FixedI64(0)", input: FixedI64(0), expect: "0.000000000"},
+		{label: "FixedI64(-1 inner)", input: FixedI64(-1), expect: "-0.000000001"},
+		{label: "FixedI64(max)", input: FixedI64(math.MaxInt64), expect: "9223372036.854775807"},
+		{label: "FixedI64(min)", input: FixedI64(math.MinInt64), expect: "-9223372036.854775808"},
+		{label: "FixedU128(max)", input: FixedU128(MaxU128()), expect: "340282366920938463463.374607431768211455"},
+		{label: "FixedI128(min)", input: FixedI128(MinI128()), expect: "-170141183460469231731.687303715884105728"},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expect, testExample.input.String())
+		})
+	}
+}
+
+func Test_FixedPoint_Parse(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		input  string
+		expect FixedI64
+		err    error
+	}{
+		{label: "integer", input: "12", expect: 12_000_000_000},
+		{label: "negative", input: "-1.25", expect: -1_250_000_000},
+		{label: "positive sign", input: "+0.000000001", expect: 1},
+		{label: "min", input: "-9223372036.854775808", expect: math.MinInt64},
+		{label: "overflow", input: "9223372036.854775808", err: errOverflow},
+		{label: "underflow", input: "-9223372037", err: errUnderflow},
+		{label: "empty", input: "", err: errInvalidFixedPoint},
+		{label: "sign only", input: "-", err: errInvalidFixedPoint},
+		{label: "double sign", input: "-+1", err: errInvalidFixedPoint},
+		{label: "no integer part", input: ".5", err: errInvalidFixedPoint},
+		{label: "no fractional part", input: "1.", err: errInvalidFixedPoint},
+		{label: "too precise", input: "0.0000000001", err: errInvalidFixedPoint},
+		{label: "not a number", input: "1e9", err: errInvalidFixedPoint},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := ParseFixedI64(testExample.input)
+
+			assert.Equal(t, testExample.err, err)
+			assert.Equal(t, testExample.expect, result)
+		})
+	}
+
+	fixedU128, err := ParseFixedU128("340282366920938463463.374607431768211455")
+	assert.NoError(t, err)
+	assert.Equal(t, FixedU128(MaxU128()), fixedU128)
+
+	_, err = ParseFixedU128("-1")
+	assert.Equal(t, errInvalidFixedPoint, err)
+
+	_, err = ParseFixedU128("-0")
+	assert.Equal(t, errInvalidFixedPoint, err)
+}
+
+func Test_FixedPoint_ToBigRat(t *testing.T) {
+	fixedI128, _ := ParseFixedI128("-2.5")
+	assert.Equal(t, big.NewRat(-5, 2), fixedI128.ToBigRat())
+	assert.Equal(t, big.NewRat(1, 1_000_000_000), FixedI64(1).ToBigRat())
+}
+
+type TupleFixedPoint struct {
+	Tuple
+	Price  FixedU128
+	Rate   FixedI64
+	Factor FixedI128
+}
+
+func Test_FixedPoint_Tuple(t *testing.T) {
+	price, _ := ParseFixedU128("1.5")
+	factor, _ := ParseFixedI128("-2.5")
+	input := TupleFixedPoint{Price: price, Rate: -1_000_000_000, Factor: factor}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, append(append(price.Bytes(), FixedI64(-1_000_000_000).Bytes()...), factor.Bytes()...), buffer.Bytes())
+
+	result := TupleFixedPoint{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
@@ -1,8 +1,8 @@
 package goscale
 
 import (
-	"bytes"
 	"encoding/binary"
+	"io"
 	"math/big"
 	"math/bits"
 )
@@ -12,6 +12,7 @@ import (
 // [1] most significant bits
 type I128 [2]U64
 
+// NewI128 converts n to I128, the values out of range wrap around like the conversions of the Go integers.
 func NewI128[N Integer](n N) I128 {
 	return anyIntegerTo128Bits[I128](n)
 }
@@ -20,12 +21,12 @@ func NewI128FromString(n string) (I128, error) {
 	return stringTo128Bits[I128](n)
 }
 
-func (n I128) Encode(buffer *bytes.Buffer) error {
-	err := n[0].Encode(buffer)
+func (n I128) Encode(writer io.Writer) error {
+	err := n[0].Encode(writer)
 	if err != nil {
 		return err
 	}
-	err = n[1].Encode(buffer)
+	err = n[1].Encode(writer)
 	if err != nil {
 		return err
 	}
@@ -36,21 +37,37 @@ func (n I128) Bytes() []byte {
 	return append(n[0].Bytes(), n[1].Bytes()...)
 }
 
-func DecodeI128(buffer *bytes.Buffer) (I128, error) {
-	low, err := DecodeU64(buffer)
-	if err != nil {
-		return I128{}, err
-	}
-	high, err := DecodeU64(buffer)
+func (n I128) EncodedLen() int {
+	return 16
+}
+
+func (n I128) MaxEncodedLen() int {
+	return 16
+}
+
+func DecodeI128(reader io.Reader) (I128, error) {
+	decoder := Decoder{Reader: reader, Type: "I128"}
+	buf := make([]byte, 16)
+	err := decoder.Read(buf)
 	if err != nil {
 		return I128{}, err
 	}
+
 	return I128{
-		low,
-		high,
+		U64(binary.LittleEndian.Uint64(buf[:8])),
+		U64(binary.LittleEndian.Uint64(buf[8:])),
 	}, nil
 }
 
+func (n *I128) Decode(reader io.Reader) error {
+	result, err := DecodeI128(reader)
+	if err != nil {
+		return err
+	}
+	*n = result
+	return nil
+}
+
 func (n I128) ToBigInt() *big.Int {
 	isNegative := n.isNegative()
 
@@ -109,19 +126,17 @@ func (n I128) Mul(other I128) I128 {
 }
 
 func (n I128) Div(other I128) I128 {
-	return bigIntToI128(
-		new(big.Int).Div(n.ToBigInt(), other.ToBigInt()),
-	)
+	quotient, _ := divModI128(n, other)
+	return quotient
 }
 
 func (n I128) Mod(other I128) I128 {
-	return bigIntToI128(
-		new(big.Int).Mod(n.ToBigInt(), other.ToBigInt()),
-	)
+	_, remainder := divModI128(n, other)
+	return remainder
 }
 
 func (n I128) Eq(other I128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) == 0
+	return n == other
 }
 
 func (n I128) Ne(other I128) bool {
@@ -129,36 +144,86 @@ func (n I128) Ne(other I128) bool {
 }
 
 func (n I128) Lt(other I128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) < 0
+	return cmpI128(n, other) < 0
 }
 
 func (n I128) Lte(other I128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) <= 0
+	return cmpI128(n, other) <= 0
 }
 
 func (n I128) Gt(other I128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) > 0
+	return cmpI128(n, other) > 0
 }
 
 func (n I128) Gte(other I128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) >= 0
+	return cmpI128(n, other) >= 0
 }
 
-func bigIntToI128(n *big.Int) I128 {
-	bytes := make([]byte, 16)
-	n.FillBytes(bytes)
-	reverseSlice(bytes)
+func (n I128) SaturatingAdd(other I128) I128 {
+	return SaturatingAdd(n, other)
+}
 
-	var result = I128{
-		U64(binary.LittleEndian.Uint64(bytes[:8])),
-		U64(binary.LittleEndian.Uint64(bytes[8:])),
+func (n I128) SaturatingSub(other I128) I128 {
+	return SaturatingSub(n, other)
+}
+
+func (n I128) SaturatingMul(other I128) I128 {
+	return SaturatingMul(n, other)
+}
+
+func (n I128) WrappingAdd(other I128) I128 {
+	return n.Add(other)
+}
+
+func (n I128) WrappingSub(other I128) I128 {
+	return n.Sub(other)
+}
+
+func (n I128) WrappingMul(other I128) I128 {
+	return n.Mul(other)
+}
+
+// cmpI128 compares the limbs as two's complement integers,
+// flipping the sign bits orders the negative values before the positive ones.
+func cmpI128(a, b I128) int {
+	a[1] ^= 1 << 63
+	b[1] ^= 1 << 63
+	return cmp128(a, b)
+}
+
+// divModI128 implements the Euclidean division of big.Int.Div and big.Int.Mod,
+// the remainder is never negative.
+func divModI128(n, d I128) (I128, I128) {
+	negN, negD := n.isNegative(), d.isNegative()
+	absN, absD := n, d
+	if negN {
+		absN = negateI128(n)
+	}
+	if negD {
+		absD = negateI128(d)
 	}
 
-	if n.Sign() < 0 {
-		result = negateI128(result)
+	q, r := divMod128(absN, absD)
+	quotient, remainder := I128(q), I128(r)
+	if negN != negD {
+		quotient = negateI128(quotient)
+	}
+	if negN && remainder != (I128{}) {
+		// round the quotient towards the negative infinity for positive divisors
+		// and towards the positive infinity for negative ones
+		if negD {
+			quotient = quotient.Add(I128{1})
+		} else {
+			quotient = quotient.Sub(I128{1})
+		}
+		remainder = I128(absD).Sub(remainder)
 	}
+	return quotient, remainder
+}
 
-	return result
+// bigIntToI128 wraps the values out of range around like bigIntToI256.
+func bigIntToI128(n *big.Int) I128 {
+	return I128(bigIntToU128(n))
 }
 
 func (n I128) isNegative() bool {
@@ -171,44 +236,3 @@ func negateI128(n I128) I128 {
 	negHigh, _ := bits.Add64(^uint64(n[1]), 0, carry)
 	return I128{U64(negLow), U64(negHigh)}
 }
-
-//func (n I128) SaturatingAdd(b Numeric) Numeric {
-//	sumLow, carry := bits.Add64(uint64(a[0]), uint64(b.(I128)[0]), 0)
-//	sumHigh, _ := bits.Add64(uint64(a[1]), uint64(b.(I128)[1]), carry)
-//	// check for overflow
-//	if a[1]&(1<<63) == 0 && b.(I128)[1]&(1<<63) == 0 && sumHigh&(1<<63) != 0 {
-//		return MaxI128()
-//	}
-//	// check for underflow
-//	if a[1]&(1<<63) != 0 && b.(I128)[1]&(1<<63) != 0 && sumHigh&(1<<63) == 0 {
-//		return MinI128()
-//	}
-//	return I128{U64(sumLow), U64(sumHigh)}
-//}
-//
-//func (n I128) SaturatingSub(b Numeric) Numeric {
-//	diffLow, borrow := bits.Sub64(uint64(a[0]), uint64(b.(I128)[0]), 0)
-//	diffHigh, _ := bits.Sub64(uint64(a[1]), uint64(b.(I128)[1]), borrow)
-//	// check for overflow
-//	if a[1]&(1<<63) == 0 && b.(I128)[1]&(1<<63) != 0 && diffHigh&(1<<63) != 0 {
-//		return MaxI128()
-//	}
-//	// check for underflow
-//	if a[1]&(1<<63) != 0 && b.(I128)[1]&(1<<63) == 0 && diffHigh&(1<<63) == 0 {
-//		return MinI128()
-//	}
-//	return I128{U64(diffLow), U64(diffHigh)}
-//}
-//
-//func (n I128) SaturatingMul(b Numeric) Numeric {
-//	result := new(big.Int).Mul(a.ToBigInt(), b.(I128).ToBigInt())
-//	// define the maximum and minimum representable I128 values
-//	maxI128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
-//	minI128 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
-//	if result.Cmp(maxI128) > 0 {
-//		return bigIntToI128(maxI128)
-//	} else if result.Cmp(minI128) < 0 {
-//		return bigIntToI128(minI128)
-//	}
-//	return bigIntToI128(result)
-//}
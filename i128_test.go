@@ -88,6 +88,28 @@ func Test_DecodeI128_Empty(t *testing.T) {
 	assert.Equal(t, I128{}, result)
 }
 
+func Test_NewI128_Wraps(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  *big.Int
+		expect I128
+	}{
+		{label: "MaxI128+1", input: new(big.Int).Add(MaxI128().ToBigInt(), big.NewInt(1)), expect: MinI128()},
+		{label: "MinI128-1", input: new(big.Int).Sub(MinI128().ToBigInt(), big.NewInt(1)), expect: MaxI128()},
+		{label: "-2^128-3", input: new(big.Int).Sub(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 128)), big.NewInt(3)), expect: NewI128(-3)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Equal(t, e.expect, NewI128(e.input))
+		})
+	}
+
+	assert.Equal(t, NewI128(-1), NewI128(MaxI256()))
+	assert.Equal(t, NewI128(0), NewI128(MinI256()))
+	assert.Equal(t, NewI128(-7), NewI128(NewI256(-7)))
+}
+
 func Test_I128_Add(t *testing.T) {
 	testExamples := []struct {
 		label  string
@@ -330,3 +352,70 @@ func Test_I128_ToBigInt(t *testing.T) {
 		})
 	}
 }
+
+func Test_I128_MatchesBigInt(t *testing.T) {
+	var operands []I128
+	for _, operand := range u128Operands() {
+		operands = append(operands, I128(operand))
+	}
+
+	for _, a := range operands {
+		for _, b := range operands {
+			cmp := a.ToBigInt().Cmp(b.ToBigInt())
+			assert.Equal(t, cmp == 0, a.Eq(b))
+			assert.Equal(t, cmp != 0, a.Ne(b))
+			assert.Equal(t, cmp < 0, a.Lt(b))
+			assert.Equal(t, cmp <= 0, a.Lte(b))
+			assert.Equal(t, cmp > 0, a.Gt(b))
+			assert.Equal(t, cmp >= 0, a.Gte(b))
+
+			if b == (I128{}) {
+				continue
+			}
+			assert.Equal(t, bigIntToI128(new(big.Int).Div(a.ToBigInt(), b.ToBigInt())), a.Div(b), "%v / %v", a, b)
+			assert.Equal(t, bigIntToI128(new(big.Int).Mod(a.ToBigInt(), b.ToBigInt())), a.Mod(b), "%v %% %v", a, b)
+		}
+	}
+}
+
+func Test_I128_NoAllocations(t *testing.T) {
+	a := NewI128(-123456789)
+	b := NewI128(1000)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		a.Div(b)
+		a.Mod(b)
+		a.Lt(b)
+		a.Eq(b)
+		a.Gte(b)
+	})
+
+	assert.Equal(t, float64(0), allocs)
+}
+
+func Benchmark_I128_Div(b *testing.B) {
+	x := I128{math.MaxUint64, 1 << 63}
+	y := I128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Div(y)
+	}
+}
+
+func Benchmark_I128_Mod(b *testing.B) {
+	x := I128{math.MaxUint64, 1 << 63}
+	y := I128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Mod(y)
+	}
+}
+
+func Benchmark_I128_Lt(b *testing.B) {
+	x := I128{math.MaxUint64, 1 << 63}
+	y := I128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Lt(y)
+	}
+}
@@ -1,23 +1,43 @@
 package goscale
 
 import (
-	"bytes"
+	"encoding/binary"
+	"io"
 )
 
 type I16 int16
 
-func (value I16) Encode(buffer *bytes.Buffer) error {
-	return U16(value).Encode(buffer)
+func (value I16) Encode(writer io.Writer) error {
+	return U16(value).Encode(writer)
 }
 
 func (value I16) Bytes() []byte {
 	return U16(value).Bytes()
 }
 
-func DecodeI16(buffer *bytes.Buffer) (I16, error) {
-	value, err := DecodeU16(buffer)
+func (value I16) EncodedLen() int {
+	return 2
+}
+
+func (value I16) MaxEncodedLen() int {
+	return 2
+}
+
+func DecodeI16(reader io.Reader) (I16, error) {
+	decoder := Decoder{Reader: reader, Type: "I16"}
+	result := make([]byte, 2)
+	err := decoder.Read(result)
 	if err != nil {
 		return 0, err
 	}
-	return I16(value), nil
+	return I16(binary.LittleEndian.Uint16(result)), nil
+}
+
+func (value *I16) Decode(reader io.Reader) error {
+	result, err := DecodeI16(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
 }
@@ -0,0 +1,154 @@
+package goscale
+
+import (
+	"io"
+	"math/big"
+)
+
+// little endian byte order, two's complement
+// [0] least significant bits
+// [3] most significant bits
+type I256 [4]U64
+
+func NewI256[N Integer](n N) I256 {
+	return anyIntegerTo256Bits[I256](n)
+}
+
+func NewI256FromString(n string) (I256, error) {
+	return stringTo256Bits[I256](n)
+}
+
+func (n I256) Encode(writer io.Writer) error {
+	for _, limb := range n {
+		err := limb.Encode(writer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n I256) Bytes() []byte {
+	bytes := make([]byte, 32)
+	putLimbs256(bytes, n)
+	return bytes
+}
+
+func (n I256) EncodedLen() int {
+	return 32
+}
+
+func (n I256) MaxEncodedLen() int {
+	return 32
+}
+
+func DecodeI256(reader io.Reader) (I256, error) {
+	decoder := Decoder{Reader: reader, Type: "I256"}
+	buf := make([]byte, 32)
+	err := decoder.Read(buf)
+	if err != nil {
+		return I256{}, err
+	}
+	return limbs256(buf), nil
+}
+
+func (n *I256) Decode(reader io.Reader) error {
+	result, err := DecodeI256(reader)
+	if err != nil {
+		return err
+	}
+	*n = result
+	return nil
+}
+
+func (n I256) ToBigInt() *big.Int {
+	isNegative := n.isNegative()
+
+	if isNegative {
+		n = negateI256(n)
+	}
+
+	result := U256(n).ToBigInt()
+
+	if isNegative {
+		result.Neg(result)
+	}
+
+	return result
+}
+
+func (n I256) Add(other I256) I256 {
+	sum, _ := add256(n, other)
+	return sum
+}
+
+func (n I256) Sub(other I256) I256 {
+	diff, _ := sub256(n, other)
+	return diff
+}
+
+// Mul wraps around on overflow, the low 256 bits of the product are the same
+// for the two's complement and the unsigned representation.
+func (n I256) Mul(other I256) I256 {
+	low, _ := mul256(n, other)
+	return I256(low)
+}
+
+func (n I256) Div(other I256) I256 {
+	return bigIntToI256(
+		new(big.Int).Div(n.ToBigInt(), other.ToBigInt()),
+	)
+}
+
+func (n I256) Mod(other I256) I256 {
+	return bigIntToI256(
+		new(big.Int).Mod(n.ToBigInt(), other.ToBigInt()),
+	)
+}
+
+func (n I256) Eq(other I256) bool {
+	return n == other
+}
+
+func (n I256) Ne(other I256) bool {
+	return !n.Eq(other)
+}
+
+func (n I256) Lt(other I256) bool {
+	return cmpI256(n, other) < 0
+}
+
+func (n I256) Lte(other I256) bool {
+	return cmpI256(n, other) <= 0
+}
+
+func (n I256) Gt(other I256) bool {
+	return cmpI256(n, other) > 0
+}
+
+func (n I256) Gte(other I256) bool {
+	return cmpI256(n, other) >= 0
+}
+
+func bigIntToI256(n *big.Int) I256 {
+	// two's complement representation
+	return I256(bigIntToU256(n))
+}
+
+func (n I256) isNegative() bool {
+	return n[3]&U64(1<<63) != 0
+}
+
+func negateI256(n I256) I256 {
+	// two's complement representation
+	negated, _ := add256([4]U64{^n[0], ^n[1], ^n[2], ^n[3]}, [4]U64{1})
+	return negated
+}
+
+// cmpI256 compares the limbs as two's complement integers,
+// flipping the sign bits orders the negative values before the positive ones.
+func cmpI256(a, b I256) int {
+	a[3] ^= 1 << 63
+	b[3] ^= 1 << 63
+	return cmp256(a, b)
+}
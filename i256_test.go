@@ -0,0 +1,141 @@
+package goscale
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodeI256(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  string
+		expect []byte
+	}{
+		{label: "Encode I256 - (0)", input: "0", expect: make([]byte, 32)},
+		{label: "Encode I256 - (42)", input: "42", expect: append([]byte{0x2a}, make([]byte, 31)...)},
+		{label: "Encode I256 - (-1)", input: "-1", expect: bytes.Repeat([]byte{0xff}, 32)},
+		{label: "Encode I256 - (-42)", input: "-42", expect: append([]byte{0xd6}, bytes.Repeat([]byte{0xff}, 31)...)},
+		{label: "Encode I256 - (MinI256)", input: "-57896044618658097711785492504343953926634992332820282019728792003956564819968", expect: append(make([]byte, 31), 0x80)},
+		{label: "Encode I256 - (MaxI256)", input: "57896044618658097711785492504343953926634992332820282019728792003956564819967", expect: append(bytes.Repeat([]byte{0xff}, 31), 0x7f)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			input, err := NewI256FromString(e.input)
+			assert.NoError(t, err)
+
+			err = input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, input.Bytes())
+			assert.Equal(t, e.input, input.ToBigInt().String())
+		})
+	}
+}
+
+func Test_DecodeI256(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect I256
+	}{
+		{label: "Decode I256 - (42)", input: append([]byte{0x2a}, make([]byte, 31)...), expect: NewI256(42)},
+		{label: "Decode I256 - (-42)", input: append([]byte{0xd6}, bytes.Repeat([]byte{0xff}, 31)...), expect: NewI256(-42)},
+		{label: "Decode I256 - (MinI256)", input: append(make([]byte, 31), 0x80), expect: MinI256()},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := DecodeI256(bytes.NewBuffer(e.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+		})
+	}
+}
+
+func Test_DecodeI256_Empty(t *testing.T) {
+	result, err := DecodeI256(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, I256{}, result)
+}
+
+func Test_I256_Arithmetic(t *testing.T) {
+	a, _ := NewI256FromString("-28948022309329048855892746252171976963317496166410141009864396001978282409984")
+	b, _ := NewI256FromString("340282366920938463463374607431768211457")
+
+	testExamples := []struct {
+		label     string
+		operation func(a, b I256) I256
+		bigOp     func(z, a, b *big.Int) *big.Int
+	}{
+		{"Add", I256.Add, (*big.Int).Add},
+		{"Sub", I256.Sub, (*big.Int).Sub},
+		{"Mul", I256.Mul, (*big.Int).Mul},
+		{"Div", I256.Div, (*big.Int).Div},
+		{"Mod", I256.Mod, (*big.Int).Mod},
+	}
+
+	modulus := new(big.Int).Lsh(big.NewInt(1), 256)
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			for _, operands := range [][2]I256{{a, b}, {b, a}, {NewI256(-7), NewI256(2)}, {MaxI256(), NewI256(-1)}} {
+				expect := testExample.bigOp(new(big.Int), operands[0].ToBigInt(), operands[1].ToBigInt())
+				// two's complement wrapping
+				expect.Mod(expect, modulus)
+
+				result := testExample.operation(operands[0], operands[1])
+
+				assert.Equal(t, I256(bigIntToU256(expect)), result)
+			}
+		})
+	}
+
+	assert.Equal(t, MinI256(), MaxI256().Add(NewI256(1)))
+	assert.Equal(t, MaxI256(), MinI256().Sub(NewI256(1)))
+}
+
+func Test_I256_Compare(t *testing.T) {
+	negative := NewI256(-1)
+	positive := NewI256(1)
+
+	assert.True(t, negative.Lt(positive))
+	assert.True(t, MinI256().Lt(negative))
+	assert.True(t, MaxI256().Gt(positive))
+	assert.True(t, negative.Lte(negative))
+	assert.True(t, positive.Gte(negative))
+	assert.True(t, negative.Eq(negative))
+	assert.True(t, negative.Ne(positive))
+	assert.False(t, positive.Lt(negative))
+	assert.False(t, negative.Gt(positive))
+}
+
+func Test_NewI256FromString_OutOfRange(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  string
+		expect error
+	}{
+		{label: "MaxI256 + 1", input: "57896044618658097711785492504343953926634992332820282019728792003956564819968", expect: errOverflow},
+		{label: "MinI256 - 1", input: "-57896044618658097711785492504343953926634992332820282019728792003956564819969", expect: errUnderflow},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			_, err := NewI256FromString(e.input)
+
+			assert.Equal(t, e.expect, err)
+		})
+	}
+
+	assert.Equal(t, NewI256(-5), NewI256(big.NewInt(-5)))
+	assert.Equal(t, "-5", NewI256(big.NewInt(-5)).ToBigInt().String())
+}
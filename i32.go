@@ -1,23 +1,43 @@
 package goscale
 
 import (
-	"bytes"
+	"encoding/binary"
+	"io"
 )
 
 type I32 int32
 
-func (value I32) Encode(buffer *bytes.Buffer) error {
-	return U32(value).Encode(buffer)
+func (value I32) Encode(writer io.Writer) error {
+	return U32(value).Encode(writer)
 }
 
 func (value I32) Bytes() []byte {
 	return U32(value).Bytes()
 }
 
-func DecodeI32(buffer *bytes.Buffer) (I32, error) {
-	value, err := DecodeU32(buffer)
+func (value I32) EncodedLen() int {
+	return 4
+}
+
+func (value I32) MaxEncodedLen() int {
+	return 4
+}
+
+func DecodeI32(reader io.Reader) (I32, error) {
+	decoder := Decoder{Reader: reader, Type: "I32"}
+	result := make([]byte, 4)
+	err := decoder.Read(result)
 	if err != nil {
 		return 0, err
 	}
-	return I32(value), nil
+	return I32(binary.LittleEndian.Uint32(result)), nil
+}
+
+func (value *I32) Decode(reader io.Reader) error {
+	result, err := DecodeI32(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
 }
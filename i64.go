@@ -1,23 +1,43 @@
 package goscale
 
 import (
-	"bytes"
+	"encoding/binary"
+	"io"
 )
 
 type I64 int64
 
-func (value I64) Encode(buffer *bytes.Buffer) error {
-	return U64(value).Encode(buffer)
+func (value I64) Encode(writer io.Writer) error {
+	return U64(value).Encode(writer)
 }
 
 func (value I64) Bytes() []byte {
 	return U64(value).Bytes()
 }
 
-func DecodeI64(buffer *bytes.Buffer) (I64, error) {
-	value, err := DecodeU64(buffer)
+func (value I64) EncodedLen() int {
+	return 8
+}
+
+func (value I64) MaxEncodedLen() int {
+	return 8
+}
+
+func DecodeI64(reader io.Reader) (I64, error) {
+	decoder := Decoder{Reader: reader, Type: "I64"}
+	result := make([]byte, 8)
+	err := decoder.Read(result)
 	if err != nil {
 		return 0, err
 	}
-	return I64(value), nil
+	return I64(binary.LittleEndian.Uint64(result)), nil
+}
+
+func (value *I64) Decode(reader io.Reader) error {
+	result, err := DecodeI64(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
 }
@@ -1,24 +1,41 @@
 package goscale
 
 import (
-	"bytes"
+	"io"
 )
 
 type I8 int8
 
-func (value I8) Encode(buffer *bytes.Buffer) error {
-	return U8(value).Encode(buffer)
+func (value I8) Encode(writer io.Writer) error {
+	return U8(value).Encode(writer)
 }
 
 func (value I8) Bytes() []byte {
 	return U8(value).Bytes()
 }
 
-func DecodeI8(buffer *bytes.Buffer) (I8, error) {
-	decoder := Decoder{Reader: buffer}
+func (value I8) EncodedLen() int {
+	return 1
+}
+
+func (value I8) MaxEncodedLen() int {
+	return 1
+}
+
+func DecodeI8(reader io.Reader) (I8, error) {
+	decoder := Decoder{Reader: reader, Type: "I8"}
 	value, err := decoder.DecodeByte()
 	if err != nil {
 		return 0, err
 	}
 	return I8(value), nil
 }
+
+func (value *I8) Decode(reader io.Reader) error {
+	result, err := DecodeI8(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
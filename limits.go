@@ -0,0 +1,241 @@
+package goscale
+
+/*
+	Decoding limits for untrusted input.
+
+	The length prefixes of Sequence, Dictionary and Str values are read from the input,
+	so a few forged bytes can request allocations of arbitrary size. The decoders bound
+	their allocations by the input left in the reader and enforce DecodeOptions when
+	they read from a LimitedReader.
+
+	DecodeOptions.Strict additionally rejects non-canonical encodings, which is required
+	for byte-for-byte compatibility with Substrate in consensus code.
+*/
+
+import (
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// maxPreallocation is the number of elements allocated upfront when the size of the input is unknown.
+const maxPreallocation = 1024
+
+// maxZeroSizedLength bounds the length of the collections of zero sized elements, e.g. Sequence[Empty],
+// when the reader has no MaxLength limit. Decoding them consumes no input, so their length can not be
+// checked against the input left.
+const maxZeroSizedLength = 1 << 20
+
+var (
+	errLengthOverflow  = errors.New("length prefix overflows int")
+	errZeroSizedLength = errors.New("length of zero sized elements exceeds the limit")
+)
+
+// DecodeOptions limits the resources spent on decoding, zero values mean no limit.
+type DecodeOptions struct {
+	// MaxLength is the maximum number of elements of a collection or bytes of a Str.
+	// Without it, the collections of zero sized elements are limited to 2^20 elements.
+	MaxLength int
+	// MaxBytes is the maximum number of bytes consumed from the input.
+	MaxBytes int64
+	// MaxDepth is the maximum nesting of collections, Option, Result and Tuple values.
+	MaxDepth int
+	// Strict rejects the non-canonical encodings, like compact integers and
+	// length prefixes that are not encoded in the shortest possible form.
+	Strict bool
+}
+
+// LimitError reports a value that exceeds one of the DecodeOptions.
+type LimitError struct {
+	Limit string
+	Max   int64
+	Value int64
+}
+
+func (e *LimitError) Error() string {
+	return "decode limit " + e.Limit + " of " + strconv.FormatInt(e.Max, 10) + " exceeded: " + strconv.FormatInt(e.Value, 10)
+}
+
+// LimitedReader enforces the DecodeOptions for all decoders reading from it.
+type LimitedReader struct {
+	Reader   io.Reader
+	Options  DecodeOptions
+	consumed int64
+	depth    int
+}
+
+func NewLimitedReader(reader io.Reader, options DecodeOptions) *LimitedReader {
+	return &LimitedReader{Reader: reader, Options: options}
+}
+
+func (r *LimitedReader) Read(p []byte) (int, error) {
+	if r.Options.MaxBytes > 0 {
+		remaining := r.Options.MaxBytes - r.consumed
+		if remaining <= 0 {
+			return 0, &LimitError{Limit: "MaxBytes", Max: r.Options.MaxBytes, Value: r.consumed + int64(len(p))}
+		}
+		if int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+	}
+
+	n, err := r.Reader.Read(p)
+	r.consumed += int64(n)
+	return n, err
+}
+
+// Consumed returns the number of bytes read so far.
+func (r *LimitedReader) Consumed() int64 {
+	return r.consumed
+}
+
+// remaining returns the number of bytes that can still be read, or -1 if unknown.
+func (r *LimitedReader) remaining() int64 {
+	left := remainingInput(r.Reader)
+	if r.Options.MaxBytes > 0 {
+		limit := r.Options.MaxBytes - r.consumed
+		if left < 0 || limit < left {
+			left = limit
+		}
+	}
+	return left
+}
+
+func (r *LimitedReader) descend() error {
+	if r.Options.MaxDepth > 0 && r.depth >= r.Options.MaxDepth {
+		return &LimitError{Limit: "MaxDepth", Max: int64(r.Options.MaxDepth), Value: int64(r.depth + 1)}
+	}
+	r.depth++
+	return nil
+}
+
+func (r *LimitedReader) ascend() {
+	r.depth--
+}
+
+// DecodeLength decodes the compact length prefix of a collection and checks it
+// against the MaxLength limit and the Strict option of the reader.
+func DecodeLength(reader io.Reader) (int, error) {
+	return decodeLength(reader, isStrict(reader))
+}
+
+// DecodeLengthStrict decodes the compact length prefix of a collection,
+// rejecting the non-canonical encodings regardless of the reader options.
+func DecodeLengthStrict(reader io.Reader) (int, error) {
+	return decodeLength(reader, true)
+}
+
+func decodeLength(reader io.Reader, strict bool) (int, error) {
+	size, bn, err := decodeCompactInteger(reader, strict)
+	if err != nil {
+		return 0, err
+	}
+	if bn != nil || size > math.MaxInt {
+		return 0, errLengthOverflow
+	}
+	length := int(size)
+
+	if limited, ok := limitedReader(reader); ok {
+		maxLength := limited.Options.MaxLength
+		if maxLength > 0 && length > maxLength {
+			return 0, &LimitError{Limit: "MaxLength", Max: int64(maxLength), Value: int64(length)}
+		}
+	}
+
+	return length, nil
+}
+
+// limitedReader returns the LimitedReader of reader, unwrapping a CountingReader like remainingInput.
+func limitedReader(reader io.Reader) (*LimitedReader, bool) {
+	switch r := reader.(type) {
+	case *LimitedReader:
+		return r, true
+	case *CountingReader:
+		return limitedReader(r.Reader)
+	default:
+		return nil, false
+	}
+}
+
+// isStrict reports whether the reader requires canonical encodings.
+func isStrict(reader io.Reader) bool {
+	limited, ok := limitedReader(reader)
+	return ok && limited.Options.Strict
+}
+
+// remainingInput returns the number of bytes left in the reader, or -1 if unknown.
+func remainingInput(reader io.Reader) int64 {
+	switch r := reader.(type) {
+	case *LimitedReader:
+		return r.remaining()
+	case *CountingReader:
+		return remainingInput(r.Reader)
+	case interface{ Len() int }:
+		// *bytes.Buffer, *bytes.Reader, *strings.Reader
+		return int64(r.Len())
+	default:
+		return -1
+	}
+}
+
+// preallocate bounds the capacity allocated for length elements by the input left in the reader,
+// the elements beyond it are appended as they are decoded.
+func preallocate(reader io.Reader, length int) int {
+	remaining := remainingInput(reader)
+	if remaining < 0 {
+		remaining = maxPreallocation
+	}
+	if int64(length) > remaining {
+		return int(remaining)
+	}
+	return length
+}
+
+// ensureRemaining fails early if the reader is known to hold fewer than length bytes.
+func ensureRemaining(reader io.Reader, length int, typeName string) error {
+	if limited, ok := limitedReader(reader); ok && limited.Options.MaxBytes > 0 {
+		if limited.consumed+int64(length) > limited.Options.MaxBytes {
+			return &LimitError{Limit: "MaxBytes", Max: limited.Options.MaxBytes, Value: limited.consumed + int64(length)}
+		}
+	}
+
+	remaining := remainingInput(reader)
+	if remaining >= 0 && int64(length) > remaining {
+		return Decoder{Reader: reader, Type: typeName}.newDecodeError(int(remaining), length)
+	}
+	return nil
+}
+
+// descend enters a nested value, failing if it exceeds the MaxDepth limit of the reader.
+func descend(reader io.Reader) error {
+	if limited, ok := limitedReader(reader); ok {
+		return limited.descend()
+	}
+	return nil
+}
+
+func ascend(reader io.Reader) {
+	if limited, ok := limitedReader(reader); ok {
+		limited.ascend()
+	}
+}
+
+// checkZeroSized rejects the lengths of the collections of zero sized elements above maxZeroSizedLength,
+// unless the reader bounds them with MaxLength, which DecodeLength already checked.
+func checkZeroSized(reader io.Reader, length int, elemTypes ...reflect.Type) error {
+	if length <= maxZeroSizedLength {
+		return nil
+	}
+	if limited, ok := limitedReader(reader); ok && limited.Options.MaxLength > 0 {
+		return nil
+	}
+
+	for _, t := range elemTypes {
+		if !isZeroSized(t) {
+			return nil
+		}
+	}
+	return errZeroSizedLength
+}
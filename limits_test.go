@@ -0,0 +1,304 @@
+package goscale
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeLength(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect int
+	}{
+		{label: "0", input: []byte{0x00}, expect: 0},
+		{label: "63", input: []byte{0xfc}, expect: 63},
+		{label: "MaxUint32", input: []byte{0x03, 0xff, 0xff, 0xff, 0xff}, expect: 1<<32 - 1},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := DecodeLength(bytes.NewBuffer(e.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+		})
+	}
+}
+
+func Test_DecodeLength_Overflow(t *testing.T) {
+	// 2^64
+	buffer := bytes.NewBuffer([]byte{0x17, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01})
+
+	_, err := DecodeLength(buffer)
+
+	assert.ErrorIs(t, err, errLengthOverflow)
+}
+
+func Test_Decode_ForgedLength(t *testing.T) {
+	// a length of MaxUint32 followed by two bytes
+	input := []byte{0x03, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02}
+
+	var examples = []struct {
+		label  string
+		reader func() io.Reader
+		decode func(reader io.Reader) error
+		expect error
+	}{
+		{
+			label:  "Str",
+			reader: func() io.Reader { return bytes.NewBuffer(input) },
+			decode: func(reader io.Reader) error { _, err := DecodeStr(reader); return err },
+			expect: io.ErrUnexpectedEOF,
+		},
+		{
+			label:  "Sequence[U8]",
+			reader: func() io.Reader { return bytes.NewReader(input) },
+			decode: func(reader io.Reader) error { _, err := DecodeSequence[U8](reader); return err },
+			expect: io.ErrUnexpectedEOF,
+		},
+		{
+			label:  "Sequence[U32] of unknown size",
+			reader: func() io.Reader { return plainReader{bytes.NewReader(input)} },
+			decode: func(reader io.Reader) error { _, err := DecodeSequence[U32](reader); return err },
+			expect: io.ErrUnexpectedEOF,
+		},
+		{
+			label:  "Dictionary[U8, U8]",
+			reader: func() io.Reader { return bytes.NewBuffer(input) },
+			decode: func(reader io.Reader) error { _, err := DecodeDictionary[U8, U8](reader); return err },
+			expect: io.ErrUnexpectedEOF,
+		},
+		{
+			label:  "Tuple",
+			reader: func() io.Reader { return bytes.NewBuffer(input) },
+			decode: func(reader io.Reader) error { return DecodeTuple(&TupleSequence{}, reader) },
+			expect: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			err := e.decode(e.reader())
+
+			assert.ErrorIs(t, err, e.expect)
+		})
+	}
+}
+
+func Test_LimitedReader(t *testing.T) {
+	var examples = []struct {
+		label   string
+		input   Encodable
+		options DecodeOptions
+		decode  func(reader io.Reader) error
+		expect  *LimitError
+	}{
+		{
+			label:   "within the limits",
+			input:   Sequence[Option[Str]]{{true, "abc"}},
+			options: DecodeOptions{MaxLength: 3, MaxBytes: 6, MaxDepth: 2},
+			decode:  func(reader io.Reader) error { _, err := DecodeSequence[Option[Str]](reader); return err },
+		},
+		{
+			label:   "Sequence MaxLength",
+			input:   Sequence[U16]{1, 2, 3},
+			options: DecodeOptions{MaxLength: 2},
+			decode:  func(reader io.Reader) error { _, err := DecodeSequence[U16](reader); return err },
+			expect:  &LimitError{Limit: "MaxLength", Max: 2, Value: 3},
+		},
+		{
+			label:   "Str MaxLength",
+			input:   Str("abc"),
+			options: DecodeOptions{MaxLength: 2},
+			decode:  func(reader io.Reader) error { _, err := DecodeStr(reader); return err },
+			expect:  &LimitError{Limit: "MaxLength", Max: 2, Value: 3},
+		},
+		{
+			label:   "Dictionary MaxLength",
+			input:   Dictionary[U8, U8]{1: 1, 2: 2},
+			options: DecodeOptions{MaxLength: 1},
+			decode:  func(reader io.Reader) error { _, err := DecodeDictionary[U8, U8](reader); return err },
+			expect:  &LimitError{Limit: "MaxLength", Max: 1, Value: 2},
+		},
+		{
+			label:   "Str MaxBytes",
+			input:   Str("abcdef"),
+			options: DecodeOptions{MaxBytes: 4},
+			decode:  func(reader io.Reader) error { _, err := DecodeStr(reader); return err },
+			expect:  &LimitError{Limit: "MaxBytes", Max: 4, Value: 7},
+		},
+		{
+			label:   "U64 MaxBytes",
+			input:   U64(1),
+			options: DecodeOptions{MaxBytes: 4},
+			decode:  func(reader io.Reader) error { _, err := DecodeU64(reader); return err },
+			expect:  &LimitError{Limit: "MaxBytes", Max: 4, Value: 8},
+		},
+		{
+			label:   "Sequence MaxDepth",
+			input:   Sequence[Sequence[Sequence[U8]]]{{{1}}},
+			options: DecodeOptions{MaxDepth: 2},
+			decode:  func(reader io.Reader) error { _, err := DecodeSequence[Sequence[Sequence[U8]]](reader); return err },
+			expect:  &LimitError{Limit: "MaxDepth", Max: 2, Value: 3},
+		},
+		{
+			label:   "Option MaxDepth",
+			input:   Option[Option[U8]]{true, Option[U8]{true, 1}},
+			options: DecodeOptions{MaxDepth: 1},
+			decode:  func(reader io.Reader) error { _, err := DecodeOption[Option[U8]](reader); return err },
+			expect:  &LimitError{Limit: "MaxDepth", Max: 1, Value: 2},
+		},
+		{
+			label:   "Tuple MaxDepth",
+			input:   Sequence[U8]{0x01, 0x00, 0x01, 0x02},
+			options: DecodeOptions{MaxDepth: 1},
+			decode:  func(reader io.Reader) error { return DecodeTuple(&TupleNested{}, reader) },
+			expect:  &LimitError{Limit: "MaxDepth", Max: 1, Value: 2},
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			reader := NewLimitedReader(bytes.NewBuffer(e.input.Bytes()), e.options)
+
+			err := e.decode(reader)
+
+			if e.expect == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var limitErr *LimitError
+			assert.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, e.expect, limitErr)
+		})
+	}
+}
+
+func Test_LimitedReader_Depth_Resets(t *testing.T) {
+	input := Sequence[Sequence[U8]]{{1}, {2}, {3}}
+	reader := NewLimitedReader(bytes.NewBuffer(input.Bytes()), DecodeOptions{MaxDepth: 2})
+
+	result, err := DecodeSequence[Sequence[U8]](reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+	assert.Equal(t, 0, reader.depth)
+	assert.Equal(t, int64(len(input.Bytes())), reader.Consumed())
+}
+
+func Test_LimitError_Error(t *testing.T) {
+	err := &LimitError{Limit: "MaxLength", Max: 2, Value: 3}
+
+	assert.Equal(t, "decode limit MaxLength of 2 exceeded: 3", err.Error())
+}
+
+func Test_Decode_ZeroSizedForgedLength(t *testing.T) {
+	// a length of 2^30 - 1 followed by no input
+	input := []byte{0x03, 0xff, 0xff, 0xff, 0x3f}
+
+	type tupleEmpty struct {
+		Tuple
+		A Sequence[Empty]
+	}
+
+	var examples = []struct {
+		label  string
+		decode func(reader io.Reader) error
+	}{
+		{
+			label:  "Sequence[Empty]",
+			decode: func(reader io.Reader) error { _, err := DecodeSequence[Empty](reader); return err },
+		},
+		{
+			label: "DecodeSequenceWith",
+			decode: func(reader io.Reader) error {
+				_, err := DecodeSequenceWith(reader, func(io.Reader) (Empty, error) { return Empty{}, nil })
+				return err
+			},
+		},
+		{
+			label:  "Set[Empty]",
+			decode: func(reader io.Reader) error { _, err := DecodeSet[Empty](reader); return err },
+		},
+		{
+			label:  "Dictionary[Empty, Empty]",
+			decode: func(reader io.Reader) error { _, err := DecodeDictionary[Empty, Empty](reader); return err },
+		},
+		{
+			label:  "OrderedDictionary[Empty, Empty]",
+			decode: func(reader io.Reader) error { _, err := DecodeOrderedDictionary[Empty, Empty](reader); return err },
+		},
+		{
+			label:  "Tuple",
+			decode: func(reader io.Reader) error { return DecodeTuple(&tupleEmpty{}, reader) },
+		},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			err := e.decode(bytes.NewBuffer(input))
+
+			assert.ErrorIs(t, err, errZeroSizedLength)
+		})
+	}
+
+	// the canonical encodings round-trip
+	result, err := DecodeSequence[Empty](bytes.NewBuffer(Sequence[Empty]{{}, {}}.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[Empty]{{}, {}}, result)
+
+	long := make(Sequence[Empty], maxPreallocation+1)
+	result, err = DecodeSequence[Empty](bytes.NewBuffer(long.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, long, result)
+
+	// MaxLength replaces the default limit
+	reader := NewLimitedReader(bytes.NewBuffer(input), DecodeOptions{MaxLength: 1000})
+	_, err = DecodeSequence[Empty](reader)
+	assert.Equal(t, &LimitError{Limit: "MaxLength", Max: 1000, Value: 1<<30 - 1}, err)
+
+	forged := ToCompact(maxZeroSizedLength + 1).Bytes()
+	_, err = DecodeSequence[Empty](bytes.NewBuffer(forged))
+	assert.ErrorIs(t, err, errZeroSizedLength)
+
+	reader = NewLimitedReader(bytes.NewBuffer(forged), DecodeOptions{MaxLength: maxZeroSizedLength + 1})
+	result, err = DecodeSequence[Empty](reader)
+	assert.NoError(t, err)
+	assert.Len(t, result, maxZeroSizedLength+1)
+}
+
+func Test_CountingReader_KeepsLimits(t *testing.T) {
+	input := Sequence[Sequence[U8]]{{1, 2, 3}}
+
+	var examples = []struct {
+		label   string
+		options DecodeOptions
+		expect  *LimitError
+	}{
+		{label: "MaxLength", options: DecodeOptions{MaxLength: 2}, expect: &LimitError{Limit: "MaxLength", Max: 2, Value: 3}},
+		{label: "MaxBytes", options: DecodeOptions{MaxBytes: 3}, expect: &LimitError{Limit: "MaxBytes", Max: 3, Value: 5}},
+		{label: "MaxDepth", options: DecodeOptions{MaxDepth: 1}, expect: &LimitError{Limit: "MaxDepth", Max: 1, Value: 2}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			reader := NewCountingReader(NewLimitedReader(bytes.NewBuffer(input.Bytes()), e.options))
+
+			_, err := DecodeSequence[Sequence[U8]](reader)
+
+			var limitErr *LimitError
+			assert.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, e.expect, limitErr)
+		})
+	}
+
+	// Strict rejects the non-canonical length prefix 0x0100
+	reader := NewCountingReader(NewLimitedReader(bytes.NewBuffer([]byte{0x01, 0x00}), DecodeOptions{Strict: true}))
+	assert.True(t, isStrict(reader))
+	_, err := DecodeLength(reader)
+	assert.Error(t, err)
+}
@@ -3,12 +3,14 @@ package goscale
 import (
 	"errors"
 	"math"
+	"math/big"
 	"math/bits"
 )
 
 var (
-	errOverflow  = errors.New("overflow")
-	errUnderflow = errors.New("underflow")
+	errOverflow       = errors.New("overflow")
+	errUnderflow      = errors.New("underflow")
+	errDivisionByZero = errors.New("division by zero")
 )
 
 func Clamp(value, min, max int) int {
@@ -52,6 +54,26 @@ func MaxU128() U128 {
 	}
 }
 
+// ff ff ff ff ff ff ff ff | ff ff ff ff ff ff ff ff | ff ff ff ff ff ff ff ff | 7f ff ff ff ff ff ff ff
+func MaxI256() I256 {
+	return I256{
+		U64(^uint64(0)),
+		U64(^uint64(0)),
+		U64(^uint64(0)),
+		U64(^uint64(0) >> 1),
+	}
+}
+
+// ff ff ff ff ff ff ff ff | ff ff ff ff ff ff ff ff | ff ff ff ff ff ff ff ff | ff ff ff ff ff ff ff ff
+func MaxU256() U256 {
+	return U256{
+		U64(^uint64(0)),
+		U64(^uint64(0)),
+		U64(^uint64(0)),
+		U64(^uint64(0)),
+	}
+}
+
 func Min32(a, b U32) U32 {
 	if a < b {
 		return a
@@ -89,8 +111,30 @@ func MinU128() U128 {
 	}
 }
 
+// 00 00 00 00 00 00 00 00 | 00 00 00 00 00 00 00 00 | 00 00 00 00 00 00 00 00 | 80 00 00 00 00 00 00 00
+func MinI256() I256 {
+	return I256{
+		U64(0),
+		U64(0),
+		U64(0),
+		U64(1 << 63),
+	}
+}
+
+// 00 00 00 00 00 00 00 00 | 00 00 00 00 00 00 00 00 | 00 00 00 00 00 00 00 00 | 00 00 00 00 00 00 00 00
+func MinU256() U256 {
+	return U256{}
+}
+
 func TrailingZeros128(n U128) uint {
-	return n.ToBigInt().TrailingZeroBits()
+	switch {
+	case n[0] != 0:
+		return uint(bits.TrailingZeros64(uint64(n[0])))
+	case n[1] != 0:
+		return 64 + uint(bits.TrailingZeros64(uint64(n[1])))
+	default:
+		return 0
+	}
 }
 
 func SaturatingAddU32(a, b U32) U32 {
@@ -128,13 +172,7 @@ func SaturatingSubU64(a, b U64) U64 {
 }
 
 func SaturatingSubU128(a, b U128) U128 {
-	low, borrow := bits.Sub64(uint64(a[0]), uint64(b[0]), 0)
-	high, _ := bits.Sub64(uint64(a[1]), uint64(b[1]), borrow)
-	// check for underflow
-	if borrow == 1 || high > uint64(a[1]) {
-		return U128{}
-	}
-	return U128{U64(low), U64(high)}
+	return SaturatingSub(a, b)
 }
 
 func SaturatingMulU64(a, b U64) U64 {
@@ -176,11 +214,111 @@ func CheckedAddU128(a, b U128) (U128, error) {
 }
 
 func CheckedSubU128(a, b U128) (U128, error) {
-	low, borrow := bits.Sub64(uint64(a[0]), uint64(b[0]), 0)
-	high, _ := bits.Sub64(uint64(a[1]), uint64(b[1]), borrow)
-	// check for underflow
-	if borrow == 1 || high > uint64(a[1]) {
-		return U128{0, 0}, errUnderflow
+	return checkedSubU128(a, b)
+}
+
+func CheckedAddU256(a, b U256) (U256, error) {
+	sum, carry := add256(a, b)
+	if carry != 0 {
+		return U256{}, errOverflow
+	}
+	return sum, nil
+}
+
+func CheckedSubU256(a, b U256) (U256, error) {
+	diff, borrow := sub256(a, b)
+	if borrow != 0 {
+		return U256{}, errUnderflow
+	}
+	return diff, nil
+}
+
+func CheckedMulU256(a, b U256) (U256, error) {
+	low, high := mul256(a, b)
+	if high != (U256{}) {
+		return U256{}, errOverflow
+	}
+	return low, nil
+}
+
+func SaturatingAddU256(a, b U256) U256 {
+	sum, err := CheckedAddU256(a, b)
+	if err != nil {
+		return MaxU256()
+	}
+	return sum
+}
+
+func SaturatingSubU256(a, b U256) U256 {
+	diff, err := CheckedSubU256(a, b)
+	if err != nil {
+		return MinU256()
+	}
+	return diff
+}
+
+func SaturatingMulU256(a, b U256) U256 {
+	product, err := CheckedMulU256(a, b)
+	if err != nil {
+		return MaxU256()
+	}
+	return product
+}
+
+func CheckedAddI256(a, b I256) (I256, error) {
+	sum := a.Add(b)
+	// the sum of two operands with the same sign can not change it
+	if a.isNegative() == b.isNegative() && sum.isNegative() != a.isNegative() {
+		if a.isNegative() {
+			return I256{}, errUnderflow
+		}
+		return I256{}, errOverflow
+	}
+	return sum, nil
+}
+
+func CheckedSubI256(a, b I256) (I256, error) {
+	diff := a.Sub(b)
+	// the difference of two operands with different signs has the sign of the minuend
+	if a.isNegative() != b.isNegative() && diff.isNegative() != a.isNegative() {
+		if a.isNegative() {
+			return I256{}, errUnderflow
+		}
+		return I256{}, errOverflow
+	}
+	return diff, nil
+}
+
+func CheckedMulI256(a, b I256) (I256, error) {
+	product := new(big.Int).Mul(a.ToBigInt(), b.ToBigInt())
+	if product.Cmp(MaxI256().ToBigInt()) > 0 {
+		return I256{}, errOverflow
+	}
+	if product.Cmp(MinI256().ToBigInt()) < 0 {
+		return I256{}, errUnderflow
+	}
+	return bigIntToI256(product), nil
+}
+
+func SaturatingAddI256(a, b I256) I256 {
+	return saturateI256(CheckedAddI256(a, b))
+}
+
+func SaturatingSubI256(a, b I256) I256 {
+	return saturateI256(CheckedSubI256(a, b))
+}
+
+func SaturatingMulI256(a, b I256) I256 {
+	return saturateI256(CheckedMulI256(a, b))
+}
+
+func saturateI256(n I256, err error) I256 {
+	switch err {
+	case errOverflow:
+		return MaxI256()
+	case errUnderflow:
+		return MinI256()
+	default:
+		return n
 	}
-	return U128{U64(low), U64(high)}, nil
 }
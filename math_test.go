@@ -242,6 +242,7 @@ func Test_SaturatingSubU128(t *testing.T) {
 		{"2-1", NewU128(2), NewU128(1), NewU128(1)},
 		{"0-1", NewU128(0), NewU128(1), NewU128(0)},
 		{"0-MaxU128", NewU128(0), MaxU128(), NewU128(0)},
+		{"2^64-1", U128{0, 1}, NewU128(1), U128{math.MaxUint64, 0}},
 	}
 
 	for _, testExample := range testExamples {
@@ -367,6 +368,8 @@ func Test_CheckedSubU128(t *testing.T) {
 		{"2-1", NewU128(2), NewU128(1), NewU128(1), false},
 		{"0-1", NewU128(0), NewU128(1), NewU128(0), true},
 		{"0-MaxU128", NewU128(0), MaxU128(), NewU128(0), true},
+		{"2^64-1", U128{0, 1}, NewU128(1), U128{math.MaxUint64, 0}, false},
+		{"2^64+1-2", U128{1, 1}, NewU128(2), U128{math.MaxUint64, 0}, false},
 	}
 
 	for _, testExample := range testExamples {
@@ -382,3 +385,83 @@ func Test_CheckedSubU128(t *testing.T) {
 		})
 	}
 }
+
+func Test_CheckedU256(t *testing.T) {
+	testExamples := []struct {
+		label     string
+		operation func(a, b U256) (U256, error)
+		a         U256
+		b         U256
+		expect    U256
+		expectErr error
+	}{
+		{"2+1", CheckedAddU256, NewU256(2), NewU256(1), NewU256(3), nil},
+		{"MaxU128+1", CheckedAddU256, NewU256(MaxU128()), NewU256(1), U256{0, 0, 1}, nil},
+		{"MaxU256+1", CheckedAddU256, MaxU256(), NewU256(1), U256{}, errOverflow},
+		{"2-1", CheckedSubU256, NewU256(2), NewU256(1), NewU256(1), nil},
+		{"2^192-1", CheckedSubU256, U256{0, 0, 0, 1}, NewU256(1), U256{math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"0-1", CheckedSubU256, NewU256(0), NewU256(1), U256{}, errUnderflow},
+		{"MaxU128*MaxU128", CheckedMulU256, NewU256(MaxU128()), NewU256(MaxU128()), U256{1, 0, math.MaxUint64 - 1, math.MaxUint64}, nil},
+		{"2^128*2^128", CheckedMulU256, U256{0, 0, 1}, U256{0, 0, 1}, U256{}, errOverflow},
+		{"MaxU256*2", CheckedMulU256, MaxU256(), NewU256(2), U256{}, errOverflow},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := testExample.operation(testExample.a, testExample.b)
+
+			assert.Equal(t, testExample.expect, result)
+			assert.Equal(t, testExample.expectErr, err)
+		})
+	}
+}
+
+func Test_SaturatingU256(t *testing.T) {
+	assert.Equal(t, MaxU256(), SaturatingAddU256(MaxU256(), NewU256(1)))
+	assert.Equal(t, NewU256(3), SaturatingAddU256(NewU256(1), NewU256(2)))
+	assert.Equal(t, MinU256(), SaturatingSubU256(NewU256(1), NewU256(2)))
+	assert.Equal(t, NewU256(1), SaturatingSubU256(NewU256(3), NewU256(2)))
+	assert.Equal(t, MaxU256(), SaturatingMulU256(MaxU256(), NewU256(2)))
+	assert.Equal(t, NewU256(6), SaturatingMulU256(NewU256(3), NewU256(2)))
+}
+
+func Test_CheckedI256(t *testing.T) {
+	testExamples := []struct {
+		label     string
+		operation func(a, b I256) (I256, error)
+		a         I256
+		b         I256
+		expect    I256
+		expectErr error
+	}{
+		{"-2+1", CheckedAddI256, NewI256(-2), NewI256(1), NewI256(-1), nil},
+		{"MaxI256+1", CheckedAddI256, MaxI256(), NewI256(1), I256{}, errOverflow},
+		{"MinI256+(-1)", CheckedAddI256, MinI256(), NewI256(-1), I256{}, errUnderflow},
+		{"MaxI256+MinI256", CheckedAddI256, MaxI256(), MinI256(), NewI256(-1), nil},
+		{"1-2", CheckedSubI256, NewI256(1), NewI256(2), NewI256(-1), nil},
+		{"MinI256-1", CheckedSubI256, MinI256(), NewI256(1), I256{}, errUnderflow},
+		{"0-MinI256", CheckedSubI256, NewI256(0), MinI256(), I256{}, errOverflow},
+		{"-3*2", CheckedMulI256, NewI256(-3), NewI256(2), NewI256(-6), nil},
+		{"MinI256*-1", CheckedMulI256, MinI256(), NewI256(-1), I256{}, errOverflow},
+		{"MaxI256*-2", CheckedMulI256, MaxI256(), NewI256(-2), I256{}, errUnderflow},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := testExample.operation(testExample.a, testExample.b)
+
+			assert.Equal(t, testExample.expect, result)
+			assert.Equal(t, testExample.expectErr, err)
+		})
+	}
+}
+
+func Test_SaturatingI256(t *testing.T) {
+	assert.Equal(t, MaxI256(), SaturatingAddI256(MaxI256(), NewI256(1)))
+	assert.Equal(t, MinI256(), SaturatingAddI256(MinI256(), NewI256(-1)))
+	assert.Equal(t, MinI256(), SaturatingSubI256(MinI256(), NewI256(1)))
+	assert.Equal(t, MaxI256(), SaturatingSubI256(NewI256(0), MinI256()))
+	assert.Equal(t, MaxI256(), SaturatingMulI256(MinI256(), NewI256(-1)))
+	assert.Equal(t, MinI256(), SaturatingMulI256(MaxI256(), NewI256(-2)))
+	assert.Equal(t, NewI256(-6), SaturatingMulI256(NewI256(3), NewI256(-2)))
+}
@@ -20,58 +20,83 @@ type Integer128 interface {
 	U128 | I128
 }
 
+type Integer256 interface {
+	U256 | I256
+}
+
 // Signed/Unsigned integer constraint, for type safety checks
 type Integer interface {
-	SignedPrimitiveInteger | UnsignedPrimitiveInteger | Integer128 | *big.Int
+	SignedPrimitiveInteger | UnsignedPrimitiveInteger | Integer128 | Integer256 | *big.Int
 }
 
 // Converts any integer value to 128 bits representation
 func anyIntegerTo128Bits[N Integer128](n any) N {
+	bn, ok := integerToBigInt(n)
+	if !ok {
+		panic("unknown type in anyIntegerTo128Bits")
+	}
+	return bigIntToGeneric[N](bn)
+}
+
+// Converts any integer value to 256 bits representation
+func anyIntegerTo256Bits[N Integer256](n any) N {
+	bn, ok := integerToBigInt(n)
+	if !ok {
+		panic("unknown type in anyIntegerTo256Bits")
+	}
+	return bigIntTo256Generic[N](bn)
+}
+
+func integerToBigInt(n any) (*big.Int, bool) {
 	switch n := n.(type) {
 	case int:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case uint:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case int8:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case uint8:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case int16:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case uint16:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case int32:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case uint32:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case int64:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(n))
+		return new(big.Int).SetInt64(n), true
 	case uint64:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(n))
+		return new(big.Int).SetUint64(n), true
 	case I8:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case U8:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case I16:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case U16:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case I32:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case U32:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case I64:
-		return bigIntToGeneric[N](new(big.Int).SetInt64(int64(n)))
+		return new(big.Int).SetInt64(int64(n)), true
 	case U64:
-		return bigIntToGeneric[N](new(big.Int).SetUint64(uint64(n)))
+		return new(big.Int).SetUint64(uint64(n)), true
 	case U128:
-		return bigIntToGeneric[N](n.ToBigInt())
+		return n.ToBigInt(), true
 	case I128:
-		return bigIntToGeneric[N](n.ToBigInt())
+		return n.ToBigInt(), true
+	case U256:
+		return n.ToBigInt(), true
+	case I256:
+		return n.ToBigInt(), true
 	case *big.Int:
-		return bigIntToGeneric[N](n)
+		return n, true
 	default:
-		panic("unknown type in anyIntegerTo128Bits")
+		return nil, false
 	}
 }
 
@@ -93,3 +118,38 @@ func bigIntToGeneric[N Integer128](bn *big.Int) N {
 		panic("unknown numeric type in bigIntToGeneric")
 	}
 }
+
+// stringTo256Bits fails on the values out of the range of N.
+func stringTo256Bits[N Integer256](s string) (N, error) {
+	bn, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return bigIntTo256Generic[N](big.NewInt(0)), errors.New("can not convert string to big.Int")
+	}
+
+	var min, max *big.Int
+	switch any(*new(N)).(type) {
+	case I256:
+		min, max = MinI256().ToBigInt(), MaxI256().ToBigInt()
+	default:
+		min, max = MinU256().ToBigInt(), MaxU256().ToBigInt()
+	}
+	if bn.Cmp(min) < 0 {
+		return bigIntTo256Generic[N](big.NewInt(0)), errUnderflow
+	}
+	if bn.Cmp(max) > 0 {
+		return bigIntTo256Generic[N](big.NewInt(0)), errOverflow
+	}
+
+	return bigIntTo256Generic[N](bn), nil
+}
+
+func bigIntTo256Generic[N Integer256](bn *big.Int) N {
+	switch reflect.Zero(reflect.TypeOf(*new(N))).Interface().(type) {
+	case I256:
+		return N(bigIntToI256(bn))
+	case U256:
+		return N(bigIntToU256(bn))
+	default:
+		panic("unknown numeric type in bigIntTo256Generic")
+	}
+}
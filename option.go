@@ -10,6 +10,7 @@ package goscale
 import (
 	"bytes"
 	"errors"
+	"io"
 )
 
 var (
@@ -32,8 +33,8 @@ func NewOption[T Encodable](value Encodable) Option[T] {
 	}
 }
 
-func (o Option[T]) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (o Option[T]) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	if !o.HasValue {
 		err := encoder.EncodeByte(0)
 		if err != nil {
@@ -45,7 +46,7 @@ func (o Option[T]) Encode(buffer *bytes.Buffer) error {
 			return err
 		}
 
-		err = o.Value.Encode(buffer)
+		err = o.Value.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -57,8 +58,31 @@ func (o Option[T]) Bytes() []byte {
 	return EncodedBytes(o)
 }
 
-func DecodeOption[T Encodable](buffer *bytes.Buffer) (Option[T], error) {
-	b, err := DecodeBool(buffer)
+func (o Option[T]) EncodedLen() int {
+	if !o.HasValue {
+		return 1
+	}
+	return 1 + EncodedLen(o.Value)
+}
+
+func (o Option[T]) maxEncodedLen() (int, bool) {
+	n, ok := MaxEncodedLen[T]()
+	return 1 + n, ok
+}
+
+// allows reflection based codecs to tell Option[T] apart from other structs
+func (o Option[T]) option() bool {
+	return bool(o.HasValue)
+}
+
+func DecodeOption[T Encodable](reader io.Reader) (Option[T], error) {
+	err := descend(reader)
+	if err != nil {
+		return Option[T]{}, err
+	}
+	defer ascend(reader)
+
+	b, err := DecodeBool(reader)
 	if err != nil {
 		return Option[T]{}, err
 	}
@@ -68,28 +92,43 @@ func DecodeOption[T Encodable](buffer *bytes.Buffer) (Option[T], error) {
 	}
 
 	if b {
-		value, err := decodeByType(*new(T), buffer)
+		value, err := decodeInto[T](reader)
 		if err != nil {
-			return Option[T]{}, err
+			return Option[T]{}, Decoder{Reader: reader, Type: "Option"}.Truncated(err)
 		}
-		option.Value = value.(T)
+		option.Value = value
 	}
 
 	return option, nil
 }
 
-func DecodeOptionWith[T Encodable](buffer *bytes.Buffer, decodeFunc func(buffer *bytes.Buffer) (T, error)) (Option[T], error) {
+func (o *Option[T]) Decode(reader io.Reader) error {
+	result, err := DecodeOption[T](reader)
+	if err != nil {
+		return err
+	}
+	*o = result
+	return nil
+}
+
+func DecodeOptionWith[T Encodable](reader io.Reader, decodeFunc func(reader io.Reader) (T, error)) (Option[T], error) {
+	err := descend(reader)
+	if err != nil {
+		return Option[T]{}, err
+	}
+	defer ascend(reader)
+
 	option := Option[T]{HasValue: false}
 
-	b, err := DecodeBool(buffer)
+	b, err := DecodeBool(reader)
 	if err != nil {
 		return Option[T]{}, err
 	}
 	if b {
 		option.HasValue = true
-		val, err := decodeFunc(buffer)
+		val, err := decodeFunc(reader)
 		if err != nil {
-			return Option[T]{}, err
+			return Option[T]{}, Decoder{Reader: reader, Type: "Option"}.Truncated(err)
 		}
 		option.Value = val
 	}
@@ -99,8 +138,8 @@ func DecodeOptionWith[T Encodable](buffer *bytes.Buffer, decodeFunc func(buffer
 
 type OptionBool Option[Bool]
 
-func (o OptionBool) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (o OptionBool) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	if !o.HasValue {
 		err := encoder.EncodeByte(0)
 		if err != nil {
@@ -128,8 +167,16 @@ func (o OptionBool) Bytes() []byte {
 	return buffer.Bytes()
 }
 
-func DecodeOptionBool(buffer *bytes.Buffer) (OptionBool, error) {
-	decoder := Decoder{Reader: buffer}
+func (o OptionBool) EncodedLen() int {
+	return 1
+}
+
+func (o OptionBool) MaxEncodedLen() int {
+	return 1
+}
+
+func DecodeOptionBool(reader io.Reader) (OptionBool, error) {
+	decoder := Decoder{Reader: reader, Type: "OptionBool"}
 	b, err := decoder.DecodeByte()
 	if err != nil {
 		return OptionBool{}, err
@@ -152,3 +199,12 @@ func DecodeOptionBool(buffer *bytes.Buffer) (OptionBool, error) {
 
 	return result, nil
 }
+
+func (o *OptionBool) Decode(reader io.Reader) error {
+	result, err := DecodeOptionBool(reader)
+	if err != nil {
+		return err
+	}
+	*o = result
+	return nil
+}
@@ -2,7 +2,6 @@ package goscale
 
 import (
 	"bytes"
-	"errors"
 	"io"
 	"math"
 	"testing"
@@ -51,7 +50,7 @@ func Test_NewOption_Panics(t *testing.T) {
 type testEncodable struct {
 }
 
-func (testEncodable) Encode(*bytes.Buffer) error {
+func (testEncodable) Encode(io.Writer) error {
 	return nil
 }
 
@@ -351,7 +350,7 @@ func Test_EncodeOptionSequenceU8(t *testing.T) {
 }
 
 func Test_EncodeOptionResult(t *testing.T) {
-	type ResultValue = Result[Sequence[U8]]
+	type ResultValue = Result[Sequence[U8], Str]
 
 	var examples = []struct {
 		label  string
@@ -360,7 +359,7 @@ func Test_EncodeOptionResult(t *testing.T) {
 	}{
 		{
 			label:  "Encode Option(true, Result(true, Seq[U8])",
-			input:  NewOption[ResultValue](ResultValue{HasError: false, Value: Sequence[U8]{42}}),
+			input:  NewOption[ResultValue](Ok[Sequence[U8], Str](Sequence[U8]{42})),
 			expect: []byte{0x1, 0x0, 0x4, 0x2a}},
 	}
 
@@ -922,7 +921,7 @@ func Test_DecodeOptionPanicsMissingBoolBytes(t *testing.T) {
 			buffer.Write(e.input)
 
 			_, err := DecodeOption[Bool](buffer)
-			assert.ErrorIs(t, io.EOF, err)
+			assert.Equal(t, &DecodeError{Type: "Option", Offset: -1, Err: io.ErrUnexpectedEOF}, err)
 		})
 	}
 }
@@ -1020,9 +1019,10 @@ func Test_DecodeOptionErrorU8MissingBytes(t *testing.T) {
 
 			_, err := DecodeOption[U16](buffer)
 
-			expectedErr := errors.New("can not read the required number of bytes 2, only 1 available")
+			expectedErr := &DecodeError{Type: "U16", Offset: -1, Read: 1, Expected: 2, Err: io.ErrUnexpectedEOF}
 
 			assert.Equal(t, expectedErr, err)
+			assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
 		})
 	}
 }
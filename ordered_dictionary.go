@@ -0,0 +1,141 @@
+package goscale
+
+/*
+	OrderedDictionary is encoded like Dictionary, but it keeps the entries in
+	their order on the wire, including the duplicate keys, so that the maps
+	encoded by non-canonical encoders are re-encoded byte for byte.
+*/
+
+import (
+	"errors"
+	"io"
+	"reflect"
+)
+
+var (
+	errDuplicateKey = errors.New("dictionary key is duplicated")
+)
+
+// KeyValue is an entry of OrderedDictionary.
+type KeyValue[K Comparable, V Encodable] struct {
+	Key   K
+	Value V
+}
+
+type OrderedDictionary[K Comparable, V Encodable] []KeyValue[K, V]
+
+// Encode writes the entries in their order, without sorting them.
+func (d OrderedDictionary[K, V]) Encode(writer io.Writer) error {
+	err := ToCompact(len(d)).Encode(writer)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range d {
+		err := entry.Key.Encode(writer)
+		if err != nil {
+			return err
+		}
+
+		err = entry.Value.Encode(writer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d OrderedDictionary[K, V]) Bytes() []byte {
+	return EncodedBytes(d)
+}
+
+func (d OrderedDictionary[K, V]) EncodedLen() int {
+	size := compactEncodedLen(uint64(len(d)))
+	for _, entry := range d {
+		size += EncodedLen(entry.Key) + EncodedLen(entry.Value)
+	}
+	return size
+}
+
+// Dictionary returns the entries as a Dictionary, the last of the duplicate keys wins like in DecodeDictionary.
+func (d OrderedDictionary[K, V]) Dictionary() Dictionary[K, V] {
+	result := make(Dictionary[K, V], len(d))
+	for _, entry := range d {
+		result[entry.Key] = entry.Value
+	}
+	return result
+}
+
+// DecodeOrderedDictionary keeps the order of the entries and the duplicate keys.
+func DecodeOrderedDictionary[K Comparable, V Encodable](reader io.Reader) (OrderedDictionary[K, V], error) {
+	return decodeOrderedDictionary[K, V](reader, false)
+}
+
+// DecodeOrderedDictionaryUnique keeps the order of the entries and fails on the duplicate keys.
+func DecodeOrderedDictionaryUnique[K Comparable, V Encodable](reader io.Reader) (OrderedDictionary[K, V], error) {
+	return decodeOrderedDictionary[K, V](reader, true)
+}
+
+// DecodeDictionaryUnique fails on the duplicate keys, but accepts the keys in any order.
+func DecodeDictionaryUnique[K Comparable, V Encodable](reader io.Reader) (Dictionary[K, V], error) {
+	result, err := decodeOrderedDictionary[K, V](reader, true)
+	if err != nil {
+		return nil, err
+	}
+	return result.Dictionary(), nil
+}
+
+func decodeOrderedDictionary[K Comparable, V Encodable](reader io.Reader, unique bool) (OrderedDictionary[K, V], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = descend(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, size, reflect.TypeOf(*new(K)), reflect.TypeOf(*new(V)))
+	if err != nil {
+		return nil, err
+	}
+
+	var seen map[K]struct{}
+	if unique {
+		seen = make(map[K]struct{}, preallocate(reader, size))
+	}
+
+	result := make(OrderedDictionary[K, V], 0, preallocate(reader, size))
+	for i := 0; i < size; i++ {
+		key, err := decodeInto[K](reader)
+		if err != nil {
+			return nil, Decoder{Reader: reader, Type: "OrderedDictionary"}.Truncated(err)
+		}
+		if unique {
+			if _, ok := seen[key]; ok {
+				return nil, errDuplicateKey
+			}
+			seen[key] = struct{}{}
+		}
+
+		value, err := decodeInto[V](reader)
+		if err != nil {
+			return nil, Decoder{Reader: reader, Type: "OrderedDictionary"}.Truncated(err)
+		}
+		result = append(result, KeyValue[K, V]{Key: key, Value: value})
+	}
+
+	return result, nil
+}
+
+func (d *OrderedDictionary[K, V]) Decode(reader io.Reader) error {
+	result, err := DecodeOrderedDictionary[K, V](reader)
+	if err != nil {
+		return err
+	}
+	*d = result
+	return nil
+}
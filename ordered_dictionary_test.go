@@ -0,0 +1,98 @@
+package goscale
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderedDictionary(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		input  []byte
+		expect OrderedDictionary[U8, Str]
+	}{
+		{
+			label:  "Empty",
+			input:  []byte{0x00},
+			expect: OrderedDictionary[U8, Str]{},
+		},
+		{
+			label:  "Unsorted",
+			input:  []byte{0x08, 0x02, 0x04, 0x62, 0x01, 0x04, 0x61},
+			expect: OrderedDictionary[U8, Str]{{Key: 2, Value: "b"}, {Key: 1, Value: "a"}},
+		},
+		{
+			label:  "Duplicate",
+			input:  []byte{0x0c, 0x01, 0x04, 0x61, 0x02, 0x00, 0x01, 0x04, 0x63},
+			expect: OrderedDictionary[U8, Str]{{Key: 1, Value: "a"}, {Key: 2, Value: ""}, {Key: 1, Value: "c"}},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := DecodeOrderedDictionary[U8, Str](bytes.NewBuffer(testExample.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, testExample.expect, result)
+			assert.Equal(t, testExample.input, result.Bytes())
+			assert.Equal(t, len(testExample.input), result.EncodedLen())
+
+			var decoded OrderedDictionary[U8, Str]
+			err = decoded.Decode(bytes.NewBuffer(testExample.input))
+			assert.NoError(t, err)
+			assert.Equal(t, testExample.expect, decoded)
+		})
+	}
+}
+
+func Test_OrderedDictionary_Dictionary(t *testing.T) {
+	d := OrderedDictionary[U8, Str]{{Key: 2, Value: "b"}, {Key: 1, Value: "a"}, {Key: 2, Value: "c"}}
+
+	assert.Equal(t, Dictionary[U8, Str]{1: "a", 2: "c"}, d.Dictionary())
+}
+
+func Test_DecodeOrderedDictionaryUnique(t *testing.T) {
+	unsorted := []byte{0x08, 0x02, 0x04, 0x62, 0x01, 0x04, 0x61}
+	duplicate := []byte{0x08, 0x01, 0x04, 0x61, 0x01, 0x04, 0x63}
+
+	result, err := DecodeOrderedDictionaryUnique[U8, Str](bytes.NewBuffer(unsorted))
+	assert.NoError(t, err)
+	assert.Equal(t, OrderedDictionary[U8, Str]{{Key: 2, Value: "b"}, {Key: 1, Value: "a"}}, result)
+
+	_, err = DecodeOrderedDictionaryUnique[U8, Str](bytes.NewBuffer(duplicate))
+	assert.Equal(t, errDuplicateKey, err)
+
+	dictionary, err := DecodeDictionaryUnique[U8, Str](bytes.NewBuffer(unsorted))
+	assert.NoError(t, err)
+	assert.Equal(t, Dictionary[U8, Str]{1: "a", 2: "b"}, dictionary)
+
+	_, err = DecodeDictionaryUnique[U8, Str](bytes.NewBuffer(duplicate))
+	assert.Equal(t, errDuplicateKey, err)
+}
+
+type TupleOrderedDictionary struct {
+	Tuple
+	A OrderedDictionary[U8, Str]
+	B U8
+}
+
+func Test_TupleOrderedDictionary(t *testing.T) {
+	input := TupleOrderedDictionary{A: OrderedDictionary[U8, Str]{{Key: 2, Value: "b"}, {Key: 1, Value: "a"}}, B: 1}
+	expect := []byte{0x08, 0x02, 0x04, 0x62, 0x01, 0x04, 0x61, 0x01}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	size, err := TupleEncodedLen(input)
+	assert.NoError(t, err)
+	assert.Equal(t, len(expect), size)
+
+	result := TupleOrderedDictionary{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
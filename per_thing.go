@@ -0,0 +1,536 @@
+package goscale
+
+/*
+	Ref: https://docs.rs/sp-arithmetic/latest/sp_arithmetic/per_things/index.html
+
+	Percent, Permill, Perbill and Perquintill are the fractions of one in parts
+	per hundred, million, billion and quintillion, encoded as their parts.
+	The arithmetic rounds like Substrate does, the decoders reject the values
+	greater than one, the compact decoders saturate them.
+*/
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+)
+
+// Rounding of the per-thing arithmetic, like Rust's sp_arithmetic::Rounding.
+type Rounding uint8
+
+const (
+	RoundingDown Rounding = iota
+	RoundingUp
+	// RoundingNearestPrefDown rounds to the nearest value, the ties down.
+	RoundingNearestPrefDown
+	// RoundingNearestPrefUp rounds to the nearest value, the ties up.
+	RoundingNearestPrefUp
+)
+
+const (
+	PercentAccuracy     = 100
+	PermillAccuracy     = 1_000_000
+	PerbillAccuracy     = 1_000_000_000
+	PerquintillAccuracy = 1_000_000_000_000_000_000
+)
+
+var (
+	errPerThingOutOfRange = errors.New("per-thing value is greater than one")
+)
+
+// PerThing is implemented by Percent, Permill, Perbill and Perquintill, which share the method set:
+// FromPercent and the saturating operations saturate at one, FromRational approximates p/q rounding
+// down and saturates at one if p > q or q is zero, FromRationalWithRounding fails instead, MulU64 and
+// MulU128 never overflow since the per-thing is at most one and SaturatingMul rounds down.
+type PerThing interface {
+	Percent | Permill | Perbill | Perquintill
+}
+
+type Percent U8
+
+func PercentFromParts(parts U8) Percent {
+	return perThingFromParts[Percent](uint64(parts))
+}
+
+func PercentFromPercent(percent U8) Percent {
+	return perThingFromPercent[Percent](uint64(percent))
+}
+
+func PercentFromRational[I CompactInteger](p, q I) Percent {
+	return perThingFromRationalSaturating[Percent](p, q)
+}
+
+func PercentFromRationalWithRounding[I CompactInteger](p, q I, rounding Rounding) (Percent, error) {
+	return perThingFromRational[Percent](p, q, rounding)
+}
+
+func (p Percent) Parts() U8 {
+	return U8(p)
+}
+
+func (p Percent) IsZero() bool {
+	return p == 0
+}
+
+func (p Percent) IsOne() bool {
+	return p == PercentAccuracy
+}
+
+func (p Percent) MulU64(n U64, rounding Rounding) U64 {
+	return perThingMul(p, U128{n}, rounding)[0]
+}
+
+func (p Percent) MulU128(n U128, rounding Rounding) U128 {
+	return perThingMul(p, n, rounding)
+}
+
+func (p Percent) SaturatingAdd(other Percent) Percent {
+	return perThingSaturatingAdd(p, other)
+}
+
+func (p Percent) SaturatingSub(other Percent) Percent {
+	return perThingSaturatingSub(p, other)
+}
+
+func (p Percent) SaturatingMul(other Percent) Percent {
+	return perThingSaturatingMul(p, other)
+}
+
+func (p Percent) Encode(writer io.Writer) error {
+	return U8(p).Encode(writer)
+}
+
+func (p Percent) Bytes() []byte {
+	return U8(p).Bytes()
+}
+
+func (p Percent) EncodedLen() int {
+	return 1
+}
+
+func (p Percent) MaxEncodedLen() int {
+	return 1
+}
+
+func (p Percent) ToBigInt() *big.Int {
+	return U8(p).ToBigInt()
+}
+
+func DecodePercent(reader io.Reader) (Percent, error) {
+	parts, err := DecodeU8(reader)
+	if err != nil {
+		return 0, err
+	}
+	return decodePerThing[Percent](uint64(parts))
+}
+
+func (p *Percent) Decode(reader io.Reader) error {
+	result, err := DecodePercent(reader)
+	if err != nil {
+		return err
+	}
+	*p = result
+	return nil
+}
+
+func DecodeCompactPercent(reader io.Reader) (Percent, error) {
+	parts, err := DecodeCompactOf[U8](reader)
+	if err != nil {
+		return 0, err
+	}
+	return PercentFromParts(parts.Value()), nil
+}
+
+type Permill U32
+
+func PermillFromParts(parts U32) Permill {
+	return perThingFromParts[Permill](uint64(parts))
+}
+
+func PermillFromPercent(percent U32) Permill {
+	return perThingFromPercent[Permill](uint64(percent))
+}
+
+func PermillFromRational[I CompactInteger](p, q I) Permill {
+	return perThingFromRationalSaturating[Permill](p, q)
+}
+
+func PermillFromRationalWithRounding[I CompactInteger](p, q I, rounding Rounding) (Permill, error) {
+	return perThingFromRational[Permill](p, q, rounding)
+}
+
+func (p Permill) Parts() U32 {
+	return U32(p)
+}
+
+func (p Permill) IsZero() bool {
+	return p == 0
+}
+
+func (p Permill) IsOne() bool {
+	return p == PermillAccuracy
+}
+
+func (p Permill) MulU64(n U64, rounding Rounding) U64 {
+	return perThingMul(p, U128{n}, rounding)[0]
+}
+
+func (p Permill) MulU128(n U128, rounding Rounding) U128 {
+	return perThingMul(p, n, rounding)
+}
+
+func (p Permill) SaturatingAdd(other Permill) Permill {
+	return perThingSaturatingAdd(p, other)
+}
+
+func (p Permill) SaturatingSub(other Permill) Permill {
+	return perThingSaturatingSub(p, other)
+}
+
+func (p Permill) SaturatingMul(other Permill) Permill {
+	return perThingSaturatingMul(p, other)
+}
+
+func (p Permill) Encode(writer io.Writer) error {
+	return U32(p).Encode(writer)
+}
+
+func (p Permill) Bytes() []byte {
+	return U32(p).Bytes()
+}
+
+func (p Permill) EncodedLen() int {
+	return 4
+}
+
+func (p Permill) MaxEncodedLen() int {
+	return 4
+}
+
+func (p Permill) ToBigInt() *big.Int {
+	return U32(p).ToBigInt()
+}
+
+func DecodePermill(reader io.Reader) (Permill, error) {
+	parts, err := DecodeU32(reader)
+	if err != nil {
+		return 0, err
+	}
+	return decodePerThing[Permill](uint64(parts))
+}
+
+func (p *Permill) Decode(reader io.Reader) error {
+	result, err := DecodePermill(reader)
+	if err != nil {
+		return err
+	}
+	*p = result
+	return nil
+}
+
+func DecodeCompactPermill(reader io.Reader) (Permill, error) {
+	parts, err := DecodeCompactOf[U32](reader)
+	if err != nil {
+		return 0, err
+	}
+	return PermillFromParts(parts.Value()), nil
+}
+
+type Perbill U32
+
+func PerbillFromParts(parts U32) Perbill {
+	return perThingFromParts[Perbill](uint64(parts))
+}
+
+func PerbillFromPercent(percent U32) Perbill {
+	return perThingFromPercent[Perbill](uint64(percent))
+}
+
+func PerbillFromRational[I CompactInteger](p, q I) Perbill {
+	return perThingFromRationalSaturating[Perbill](p, q)
+}
+
+func PerbillFromRationalWithRounding[I CompactInteger](p, q I, rounding Rounding) (Perbill, error) {
+	return perThingFromRational[Perbill](p, q, rounding)
+}
+
+func (p Perbill) Parts() U32 {
+	return U32(p)
+}
+
+func (p Perbill) IsZero() bool {
+	return p == 0
+}
+
+func (p Perbill) IsOne() bool {
+	return p == PerbillAccuracy
+}
+
+func (p Perbill) MulU64(n U64, rounding Rounding) U64 {
+	return perThingMul(p, U128{n}, rounding)[0]
+}
+
+func (p Perbill) MulU128(n U128, rounding Rounding) U128 {
+	return perThingMul(p, n, rounding)
+}
+
+func (p Perbill) SaturatingAdd(other Perbill) Perbill {
+	return perThingSaturatingAdd(p, other)
+}
+
+func (p Perbill) SaturatingSub(other Perbill) Perbill {
+	return perThingSaturatingSub(p, other)
+}
+
+func (p Perbill) SaturatingMul(other Perbill) Perbill {
+	return perThingSaturatingMul(p, other)
+}
+
+func (p Perbill) Encode(writer io.Writer) error {
+	return U32(p).Encode(writer)
+}
+
+func (p Perbill) Bytes() []byte {
+	return U32(p).Bytes()
+}
+
+func (p Perbill) EncodedLen() int {
+	return 4
+}
+
+func (p Perbill) MaxEncodedLen() int {
+	return 4
+}
+
+func (p Perbill) ToBigInt() *big.Int {
+	return U32(p).ToBigInt()
+}
+
+func DecodePerbill(reader io.Reader) (Perbill, error) {
+	parts, err := DecodeU32(reader)
+	if err != nil {
+		return 0, err
+	}
+	return decodePerThing[Perbill](uint64(parts))
+}
+
+func (p *Perbill) Decode(reader io.Reader) error {
+	result, err := DecodePerbill(reader)
+	if err != nil {
+		return err
+	}
+	*p = result
+	return nil
+}
+
+func DecodeCompactPerbill(reader io.Reader) (Perbill, error) {
+	parts, err := DecodeCompactOf[U32](reader)
+	if err != nil {
+		return 0, err
+	}
+	return PerbillFromParts(parts.Value()), nil
+}
+
+type Perquintill U64
+
+func PerquintillFromParts(parts U64) Perquintill {
+	return perThingFromParts[Perquintill](uint64(parts))
+}
+
+func PerquintillFromPercent(percent U64) Perquintill {
+	return perThingFromPercent[Perquintill](uint64(percent))
+}
+
+func PerquintillFromRational[I CompactInteger](p, q I) Perquintill {
+	return perThingFromRationalSaturating[Perquintill](p, q)
+}
+
+func PerquintillFromRationalWithRounding[I CompactInteger](p, q I, rounding Rounding) (Perquintill, error) {
+	return perThingFromRational[Perquintill](p, q, rounding)
+}
+
+func (p Perquintill) Parts() U64 {
+	return U64(p)
+}
+
+func (p Perquintill) IsZero() bool {
+	return p == 0
+}
+
+func (p Perquintill) IsOne() bool {
+	return p == PerquintillAccuracy
+}
+
+func (p Perquintill) MulU64(n U64, rounding Rounding) U64 {
+	return perThingMul(p, U128{n}, rounding)[0]
+}
+
+func (p Perquintill) MulU128(n U128, rounding Rounding) U128 {
+	return perThingMul(p, n, rounding)
+}
+
+func (p Perquintill) SaturatingAdd(other Perquintill) Perquintill {
+	return perThingSaturatingAdd(p, other)
+}
+
+func (p Perquintill) SaturatingSub(other Perquintill) Perquintill {
+	return perThingSaturatingSub(p, other)
+}
+
+func (p Perquintill) SaturatingMul(other Perquintill) Perquintill {
+	return perThingSaturatingMul(p, other)
+}
+
+func (p Perquintill) Encode(writer io.Writer) error {
+	return U64(p).Encode(writer)
+}
+
+func (p Perquintill) Bytes() []byte {
+	return U64(p).Bytes()
+}
+
+func (p Perquintill) EncodedLen() int {
+	return 8
+}
+
+func (p Perquintill) MaxEncodedLen() int {
+	return 8
+}
+
+func (p Perquintill) ToBigInt() *big.Int {
+	return U64(p).ToBigInt()
+}
+
+func DecodePerquintill(reader io.Reader) (Perquintill, error) {
+	parts, err := DecodeU64(reader)
+	if err != nil {
+		return 0, err
+	}
+	return decodePerThing[Perquintill](uint64(parts))
+}
+
+func (p *Perquintill) Decode(reader io.Reader) error {
+	result, err := DecodePerquintill(reader)
+	if err != nil {
+		return err
+	}
+	*p = result
+	return nil
+}
+
+func DecodeCompactPerquintill(reader io.Reader) (Perquintill, error) {
+	parts, err := DecodeCompactOf[U64](reader)
+	if err != nil {
+		return 0, err
+	}
+	return PerquintillFromParts(parts.Value()), nil
+}
+
+func perThingAccuracy[P PerThing]() uint64 {
+	switch any(*new(P)).(type) {
+	case Percent:
+		return PercentAccuracy
+	case Permill:
+		return PermillAccuracy
+	case Perbill:
+		return PerbillAccuracy
+	default:
+		return PerquintillAccuracy
+	}
+}
+
+func perThingFromParts[P PerThing](parts uint64) P {
+	accuracy := perThingAccuracy[P]()
+	if parts > accuracy {
+		parts = accuracy
+	}
+	return P(parts)
+}
+
+func perThingFromPercent[P PerThing](percent uint64) P {
+	if percent > 100 {
+		percent = 100
+	}
+	return P(percent * (perThingAccuracy[P]() / 100))
+}
+
+func decodePerThing[P PerThing](parts uint64) (P, error) {
+	if parts > perThingAccuracy[P]() {
+		return 0, errPerThingOutOfRange
+	}
+	return P(parts), nil
+}
+
+func perThingFromRational[P PerThing, I CompactInteger](p, q I, rounding Rounding) (P, error) {
+	numerator, denominator := p.ToBigInt(), q.ToBigInt()
+	if denominator.Sign() == 0 {
+		return 0, errDivisionByZero
+	}
+	if numerator.Cmp(denominator) > 0 {
+		return 0, errPerThingOutOfRange
+	}
+
+	numerator.Mul(numerator, new(big.Int).SetUint64(perThingAccuracy[P]()))
+	parts, remainder := numerator.QuoRem(numerator, denominator, new(big.Int))
+	half := remainder.Lsh(remainder, 1).Cmp(denominator)
+	if roundsUp(rounding, remainder.Sign() != 0, half) {
+		parts.Add(parts, big.NewInt(1))
+	}
+	return P(parts.Uint64()), nil
+}
+
+func perThingFromRationalSaturating[P PerThing, I CompactInteger](p, q I) P {
+	result, err := perThingFromRational[P](p, q, RoundingDown)
+	if err != nil {
+		return P(perThingAccuracy[P]())
+	}
+	return result
+}
+
+// perThingMul returns p * n, splitting n by the accuracy so that the products fit in 128 bits.
+func perThingMul[P PerThing](p P, n U128, rounding Rounding) U128 {
+	accuracy := U128{U64(perThingAccuracy[P]())}
+	parts := U128{U64(p)}
+
+	whole, remainder := divMod128(n, accuracy)
+	fraction, fractionRemainder := divMod128(remainder.Mul(parts), accuracy)
+	// the remainder is less than the accuracy, doubling it does not overflow
+	half := cmp128(U128{fractionRemainder[0] << 1}, accuracy)
+	if roundsUp(rounding, fractionRemainder[0] != 0, half) {
+		fraction = fraction.Add(U128{1})
+	}
+	return whole.Mul(parts).Add(fraction)
+}
+
+func perThingSaturatingAdd[P PerThing](a, b P) P {
+	return perThingFromParts[P](uint64(a) + uint64(b))
+}
+
+func perThingSaturatingSub[P PerThing](a, b P) P {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+func perThingSaturatingMul[P PerThing](a, b P) P {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	parts, _ := bits.Div64(hi, lo, perThingAccuracy[P]())
+	return P(parts)
+}
+
+// roundsUp reports whether the truncated quotient is incremented,
+// half compares the doubled remainder with the divisor.
+func roundsUp(rounding Rounding, hasRemainder bool, half int) bool {
+	switch rounding {
+	case RoundingUp:
+		return hasRemainder
+	case RoundingNearestPrefDown:
+		return half > 0
+	case RoundingNearestPrefUp:
+		return hasRemainder && half >= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+package goscale
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PerThing_Encode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Encodable
+		expect []byte
+	}{
+		{label: "Encode Percent(50)", input: PercentFromPercent(50), expect: []byte{0x32}},
+		{label: "Encode Permill(1)", input: PermillFromParts(1_000_000), expect: []byte{0x40, 0x42, 0x0f, 0x00}},
+		{label: "Encode Perbill(10%)", input: PerbillFromPercent(10), expect: []byte{0x00, 0xe1, 0xf5, 0x05}},
+		{label: "Encode Perquintill(1)", input: PerquintillFromPercent(100), expect: []byte{0x00, 0x00, 0x64, 0xa7, 0xb3, 0xb6, 0xe0, 0x0d}},
+		{label: "Encode Compact(Perbill(10%))", input: ToCompact(PerbillFromPercent(10)), expect: []byte{0x02, 0x84, 0xd7, 0x17}},
+		{label: "Encode Compact(Percent(50))", input: ToCompact(PercentFromPercent(50)), expect: []byte{0xc8}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+		})
+	}
+}
+
+func Test_PerThing_Decode(t *testing.T) {
+	percent, err := DecodePercent(bytes.NewBuffer([]byte{0x64}))
+	assert.NoError(t, err)
+	assert.True(t, percent.IsOne())
+
+	permill, err := DecodePermill(bytes.NewBuffer([]byte{0x01, 0x00, 0x00, 0x00}))
+	assert.NoError(t, err)
+	assert.Equal(t, Permill(1), permill)
+
+	var perbill Perbill
+	err = perbill.Decode(bytes.NewBuffer([]byte{0x00, 0xe1, 0xf5, 0x05}))
+	assert.NoError(t, err)
+	assert.Equal(t, PerbillFromPercent(10), perbill)
+
+	perquintill, err := DecodePerquintill(bytes.NewBuffer([]byte{0x00, 0x00, 0x64, 0xa7, 0xb3, 0xb6, 0xe0, 0x0d}))
+	assert.NoError(t, err)
+	assert.True(t, perquintill.IsOne())
+
+	_, err = DecodePercent(bytes.NewBuffer([]byte{0x65}))
+	assert.Equal(t, errPerThingOutOfRange, err)
+
+	_, err = DecodePerbill(bytes.NewBuffer([]byte{0x01, 0xca, 0x9a, 0x3b}))
+	assert.Equal(t, errPerThingOutOfRange, err)
+
+	_, err = DecodePerbill(bytes.NewBuffer([]byte{0x01}))
+	assert.Error(t, err)
+}
+
+func Test_PerThing_DecodeCompact(t *testing.T) {
+	perbill, err := DecodeCompactPerbill(bytes.NewBuffer([]byte{0x02, 0x84, 0xd7, 0x17}))
+	assert.NoError(t, err)
+	assert.Equal(t, PerbillFromPercent(10), perbill)
+
+	// values greater than one saturate
+	percent, err := DecodeCompactPercent(bytes.NewBuffer([]byte{0xfd, 0x03}))
+	assert.NoError(t, err)
+	assert.Equal(t, Percent(100), percent)
+
+	_, err = DecodeCompactPercent(bytes.NewBuffer([]byte{0x01, 0x04}))
+	assert.ErrorIs(t, err, errCompactOverflow)
+}
+
+func Test_PerThing_FromParts(t *testing.T) {
+	assert.Equal(t, Percent(100), PercentFromParts(255))
+	assert.Equal(t, Permill(PermillAccuracy), PermillFromParts(math.MaxUint32))
+	assert.Equal(t, Perbill(42), PerbillFromParts(42))
+	assert.Equal(t, Perquintill(PerquintillAccuracy), PerquintillFromParts(math.MaxUint64))
+	assert.Equal(t, Perbill(PerbillAccuracy), PerbillFromPercent(101))
+	assert.Equal(t, Permill(420_000), PermillFromPercent(42))
+	assert.Equal(t, U32(42), PerbillFromParts(42).Parts())
+	assert.True(t, Perbill(0).IsZero())
+}
+
+func Test_PerThing_FromRational(t *testing.T) {
+	testExamples := []struct {
+		label     string
+		result    func() (any, error)
+		expect    any
+		expectErr error
+	}{
+		{"Percent 1/3 Down", func() (any, error) { return PercentFromRationalWithRounding(U32(1), 3, RoundingDown) }, Percent(33), nil},
+		{"Percent 1/3 Up", func() (any, error) { return PercentFromRationalWithRounding(U32(1), 3, RoundingUp) }, Percent(34), nil},
+		{"Percent 2/3 NearestPrefDown", func() (any, error) { return PercentFromRationalWithRounding(U32(2), 3, RoundingNearestPrefDown) }, Percent(67), nil},
+		{"Percent 1/200 NearestPrefDown", func() (any, error) { return PercentFromRationalWithRounding(U32(1), 200, RoundingNearestPrefDown) }, Percent(0), nil},
+		{"Percent 1/200 NearestPrefUp", func() (any, error) { return PercentFromRationalWithRounding(U32(1), 200, RoundingNearestPrefUp) }, Percent(1), nil},
+		{"Percent 0/200 NearestPrefUp", func() (any, error) { return PercentFromRationalWithRounding(U32(0), 200, RoundingNearestPrefUp) }, Percent(0), nil},
+		{"Perbill 1/3 Down", func() (any, error) { return PerbillFromRationalWithRounding(U64(1), 3, RoundingDown) }, Perbill(333_333_333), nil},
+		{"Perquintill MaxU128/MaxU128", func() (any, error) {
+			return PerquintillFromRationalWithRounding(MaxU128(), MaxU128(), RoundingDown)
+		}, Perquintill(PerquintillAccuracy), nil},
+		{"Perquintill 1/MaxU128 Up", func() (any, error) {
+			return PerquintillFromRationalWithRounding(NewU128(1), MaxU128(), RoundingUp)
+		}, Perquintill(1), nil},
+		{"Permill 2/1", func() (any, error) { return PermillFromRationalWithRounding(U8(2), 1, RoundingDown) }, Permill(0), errPerThingOutOfRange},
+		{"Permill 1/0", func() (any, error) { return PermillFromRationalWithRounding(U8(1), 0, RoundingDown) }, Permill(0), errDivisionByZero},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := testExample.result()
+
+			assert.Equal(t, testExample.expectErr, err)
+			assert.Equal(t, testExample.expect, result)
+		})
+	}
+
+	assert.Equal(t, Perbill(333_333_333), PerbillFromRational(U64(1), 3))
+	assert.Equal(t, Perbill(PerbillAccuracy), PerbillFromRational(U64(2), 1))
+	assert.Equal(t, Perbill(PerbillAccuracy), PerbillFromRational(U64(1), 0))
+}
+
+func Test_PerThing_Mul(t *testing.T) {
+	testExamples := []struct {
+		label  string
+		result any
+		expect any
+	}{
+		{"Percent(50) * 3 Down", PercentFromPercent(50).MulU64(3, RoundingDown), U64(1)},
+		{"Percent(50) * 3 Up", PercentFromPercent(50).MulU64(3, RoundingUp), U64(2)},
+		{"Percent(50) * 3 NearestPrefDown", PercentFromPercent(50).MulU64(3, RoundingNearestPrefDown), U64(1)},
+		{"Percent(50) * 3 NearestPrefUp", PercentFromPercent(50).MulU64(3, RoundingNearestPrefUp), U64(2)},
+		{"Percent(60) * 3 NearestPrefDown", PercentFromPercent(60).MulU64(3, RoundingNearestPrefDown), U64(2)},
+		{"Percent(40) * 3 NearestPrefUp", PercentFromPercent(40).MulU64(3, RoundingNearestPrefUp), U64(1)},
+		{"Perbill(1) * MaxU64", PerbillFromPercent(100).MulU64(math.MaxUint64, RoundingDown), U64(math.MaxUint64)},
+		{"Perbill(1/3) * MaxU64 Down", PerbillFromParts(333_333_333).MulU64(math.MaxUint64, RoundingDown), U64(6148914685087602513)},
+		{"Perbill(1/3) * MaxU64 Up", PerbillFromParts(333_333_333).MulU64(math.MaxUint64, RoundingUp), U64(6148914685087602514)},
+		{"Perquintill(1) * MaxU128", PerquintillFromPercent(100).MulU128(MaxU128(), RoundingUp), MaxU128()},
+		{"Perquintill(50%) * MaxU128 Down", PerquintillFromPercent(50).MulU128(MaxU128(), RoundingDown), U128{math.MaxUint64, math.MaxUint64 >> 1}},
+		{"Perquintill(50%) * MaxU128 NearestPrefUp", PerquintillFromPercent(50).MulU128(MaxU128(), RoundingNearestPrefUp), U128{0, 1 << 63}},
+		{"Permill(0) * MaxU128", Permill(0).MulU128(MaxU128(), RoundingUp), NewU128(0)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expect, testExample.result)
+		})
+	}
+}
+
+func Test_PerThing_Saturating(t *testing.T) {
+	assert.Equal(t, Percent(100), PercentFromPercent(60).SaturatingAdd(PercentFromPercent(60)))
+	assert.Equal(t, Percent(70), PercentFromPercent(60).SaturatingAdd(PercentFromPercent(10)))
+	assert.Equal(t, Perbill(0), PerbillFromPercent(10).SaturatingSub(PerbillFromPercent(60)))
+	assert.Equal(t, PerbillFromPercent(50), PerbillFromPercent(60).SaturatingSub(PerbillFromPercent(10)))
+	assert.Equal(t, PermillFromPercent(25), PermillFromPercent(50).SaturatingMul(PermillFromPercent(50)))
+	assert.Equal(t, Perquintill(PerquintillAccuracy), PerquintillFromPercent(100).SaturatingMul(PerquintillFromPercent(100)))
+	// rounds down
+	assert.Equal(t, Percent(0), Percent(1).SaturatingMul(Percent(99)))
+	assert.Equal(t, Perquintill(PerquintillAccuracy), PerquintillFromParts(PerquintillAccuracy-1).SaturatingAdd(PerquintillFromParts(PerquintillAccuracy-1)))
+}
+
+type TuplePerThing struct {
+	Tuple
+	Fee    Perbill
+	Reward Percent
+}
+
+func Test_PerThing_Tuple(t *testing.T) {
+	input := TuplePerThing{Fee: PerbillFromPercent(10), Reward: PercentFromPercent(5)}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0xe1, 0xf5, 0x05, 0x05}, buffer.Bytes())
+
+	result := TuplePerThing{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	err = DecodeTuple(&result, bytes.NewBuffer([]byte{0x00, 0xe1, 0xf5, 0x05, 0x65}))
+	assert.ErrorIs(t, err, errPerThingOutOfRange)
+}
@@ -4,58 +4,135 @@ package goscale
 	Ref: https://spec.polkadot.network/#defn-result-type)
 
 	SCALE Result Type.
+
+	Result[T, E] is encoded as 0x00 followed by the Value of type T on success,
+	or 0x01 followed by the Err of type E on error.
 */
 
 import (
-	"bytes"
+	"errors"
+	"io"
+)
+
+var (
+	errUnwrapErr = errors.New("called Unwrap on an error Result")
+	errUnwrapOk  = errors.New("called UnwrapErr on a success Result")
 )
 
-type Result[T Encodable] struct {
+type Result[T, E Encodable] struct {
 	HasError Bool
 	Value    T
+	Err      E
 }
 
-func (r Result[T]) Encode(buffer *bytes.Buffer) error {
-	err := (r.HasError).Encode(buffer)
-	if err != nil {
-		return err
+// Ok returns the success Result with value.
+func Ok[T, E Encodable](value T) Result[T, E] {
+	return Result[T, E]{Value: value}
+}
+
+// Err returns the error Result with err.
+func Err[T, E Encodable](err E) Result[T, E] {
+	return Result[T, E]{HasError: true, Err: err}
+}
+
+func (r Result[T, E]) IsOk() bool {
+	return !bool(r.HasError)
+}
+
+func (r Result[T, E]) IsErr() bool {
+	return bool(r.HasError)
+}
+
+// Unwrap returns the success value, it panics if r is an error.
+func (r Result[T, E]) Unwrap() T {
+	if r.HasError {
+		panic(errUnwrapErr)
+	}
+	return r.Value
+}
+
+// UnwrapErr returns the error value, it panics if r is a success.
+func (r Result[T, E]) UnwrapErr() E {
+	if !r.HasError {
+		panic(errUnwrapOk)
 	}
-	err = r.Value.Encode(buffer)
+	return r.Err
+}
+
+// MapResult applies f to the success value of r and keeps its error.
+func MapResult[T, E, U Encodable](r Result[T, E], f func(T) U) Result[U, E] {
+	if r.HasError {
+		return Err[U, E](r.Err)
+	}
+	return Ok[U, E](f(r.Value))
+}
+
+func (r Result[T, E]) Encode(writer io.Writer) error {
+	err := r.HasError.Encode(writer)
 	if err != nil {
 		return err
 	}
-	return nil
+	if r.HasError {
+		return r.Err.Encode(writer)
+	}
+	return r.Value.Encode(writer)
 }
 
-func (r Result[T]) Bytes() []byte {
+func (r Result[T, E]) Bytes() []byte {
 	return EncodedBytes(r)
 }
 
-func DecodeResult[T, E Encodable](buffer *bytes.Buffer, decodeValid func(*bytes.Buffer) (T, error), decodeErr func(*bytes.Buffer) (E, error)) (Result[Encodable], error) {
-	hasError, err := DecodeBool(buffer)
+func (r Result[T, E]) EncodedLen() int {
+	if r.HasError {
+		return 1 + EncodedLen(r.Err)
+	}
+	return 1 + EncodedLen(r.Value)
+}
+
+func (r Result[T, E]) maxEncodedLen() (int, bool) {
+	value, valueOk := MaxEncodedLen[T]()
+	err, errOk := MaxEncodedLen[E]()
+	return 1 + max(value, err), valueOk && errOk
+}
+
+// allows decodeByType and the reflection based codecs to handle Result[T, E]
+func (r Result[T, E]) decodeResult(reader io.Reader) (Encodable, error) {
+	return DecodeResult[T, E](reader)
+}
+
+// DecodeResult reads the error flag followed by a value of type T on success or E on error.
+func DecodeResult[T, E Encodable](reader io.Reader) (Result[T, E], error) {
+	err := descend(reader)
 	if err != nil {
-		return Result[Encodable]{}, err
+		return Result[T, E]{}, err
+	}
+	defer ascend(reader)
+
+	hasError, err := DecodeBool(reader)
+	if err != nil {
+		return Result[T, E]{}, err
 	}
 
 	if hasError {
-		value, err := decodeErr(buffer)
+		value, err := decodeInto[E](reader)
 		if err != nil {
-			return Result[Encodable]{}, err
+			return Result[T, E]{}, Decoder{Reader: reader, Type: "Result"}.Truncated(err)
 		}
-
-		return Result[Encodable]{
-			HasError: hasError,
-			Value:    value,
-		}, nil
+		return Err[T, E](value), nil
 	}
 
-	value, err := decodeValid(buffer)
+	value, err := decodeInto[T](reader)
 	if err != nil {
-		return Result[Encodable]{}, err
+		return Result[T, E]{}, Decoder{Reader: reader, Type: "Result"}.Truncated(err)
 	}
+	return Ok[T, E](value), nil
+}
 
-	return Result[Encodable]{
-		HasError: hasError,
-		Value:    value,
-	}, nil
+func (r *Result[T, E]) Decode(reader io.Reader) error {
+	result, err := DecodeResult[T, E](reader)
+	if err != nil {
+		return err
+	}
+	*r = result
+	return nil
 }
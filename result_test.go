@@ -12,31 +12,31 @@ import (
 func Test_Result_Encode(t *testing.T) {
 	var examples = []struct {
 		label  string
-		input  Result[Encodable]
+		input  Result[Encodable, Encodable]
 		expect []byte
 	}{
-		{label: "Encode Result(false, false)", input: Result[Encodable]{HasError: false, Value: Bool(false)}, expect: []byte{0x0, 0x0}},
-		{label: "Encode Result(false, true)", input: Result[Encodable]{HasError: false, Value: Bool(true)}, expect: []byte{0x0, 0x1}},
-		{label: "Encode Result(true, empty)", input: Result[Encodable]{HasError: true, Value: Empty{}}, expect: []byte{0x1}},
-		{label: "Encode Result(true, true)", input: Result[Encodable]{HasError: true, Value: Bool(true)}, expect: []byte{0x1, 0x1}},
-
-		{label: "Encode Result(false, U8(max))", input: Result[Encodable]{HasError: false, Value: U8(math.MaxUint8)}, expect: []byte{0x0, 0xff}},
-		{label: "Encode Result(false, I8(min))", input: Result[Encodable]{HasError: false, Value: I8(math.MinInt8)}, expect: []byte{0x0, 0x80}},
-		{label: "Encode Result(false, I8(max))", input: Result[Encodable]{HasError: false, Value: I8(math.MaxInt8)}, expect: []byte{0x0, 0x7f}},
-		{label: "Encode Result(false, U16(max))", input: Result[Encodable]{HasError: false, Value: U16(math.MaxUint16)}, expect: []byte{0x0, 0xff, 0xff}},
-		{label: "Encode Result(false, I16(min))", input: Result[Encodable]{HasError: false, Value: I16(math.MinInt16)}, expect: []byte{0x0, 0x00, 0x80}},
-		{label: "Encode Result(false, U32(max))", input: Result[Encodable]{HasError: false, Value: U32(math.MaxUint32)}, expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff}},
-		{label: "Encode Result(false, I32(min))", input: Result[Encodable]{HasError: false, Value: I32(math.MinInt32)}, expect: []byte{0x0, 0x0, 0x0, 0x0, 0x80}},
-		{label: "Encode Result(false, U64(max))", input: Result[Encodable]{HasError: false, Value: U64(math.MaxUint64)}, expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
-		{label: "Encode Result(false, I64(min))", input: Result[Encodable]{HasError: false, Value: I64(math.MinInt64)}, expect: []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
-		{label: "Encode Result(true, I64(min))", input: Result[Encodable]{HasError: true, Value: I64(math.MinInt64)}, expect: []byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
-		{label: "Encode Result(false, U128(max))", input: Result[Encodable]{HasError: false, Value: U128{math.MaxUint64, math.MaxUint64}}, expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
-		{label: "Encode Result(true, I128(min)", input: Result[Encodable]{HasError: true, Value: I128{U64(0), U64(math.MaxInt64 + 1)}}, expect: []byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
-		{label: "Encode Result(false, Compact(MaxUint64)", input: Result[Encodable]{HasError: false, Value: ToCompact(uint64(math.MaxUint64))}, expect: []byte{0x0, 0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
-
-		{label: "Encode Result(false, empty Seq[U8])", input: Result[Encodable]{HasError: false, Value: Sequence[U8]{}}, expect: []byte{0x0, 0x0}},
-		{label: "Encode Result(false, Seq[U8])", input: Result[Encodable]{HasError: false, Value: Sequence[U8]{42}}, expect: []byte{0x0, 0x4, 0x2a}},
-		{label: "Encode Result(false, Result(false, Seq[U8])", input: Result[Encodable]{HasError: false, Value: Result[Encodable]{HasError: true, Value: Sequence[U8]{42, 43}}}, expect: []byte{0x0, 0x1, 0x8, 0x2a, 0x2b}},
+		{label: "Encode Result(false, false)", input: Ok[Encodable, Encodable](Bool(false)), expect: []byte{0x0, 0x0}},
+		{label: "Encode Result(false, true)", input: Ok[Encodable, Encodable](Bool(true)), expect: []byte{0x0, 0x1}},
+		{label: "Encode Result(true, empty)", input: Err[Encodable, Encodable](Empty{}), expect: []byte{0x1}},
+		{label: "Encode Result(true, true)", input: Err[Encodable, Encodable](Bool(true)), expect: []byte{0x1, 0x1}},
+
+		{label: "Encode Result(false, U8(max))", input: Ok[Encodable, Encodable](U8(math.MaxUint8)), expect: []byte{0x0, 0xff}},
+		{label: "Encode Result(false, I8(min))", input: Ok[Encodable, Encodable](I8(math.MinInt8)), expect: []byte{0x0, 0x80}},
+		{label: "Encode Result(false, I8(max))", input: Ok[Encodable, Encodable](I8(math.MaxInt8)), expect: []byte{0x0, 0x7f}},
+		{label: "Encode Result(false, U16(max))", input: Ok[Encodable, Encodable](U16(math.MaxUint16)), expect: []byte{0x0, 0xff, 0xff}},
+		{label: "Encode Result(false, I16(min))", input: Ok[Encodable, Encodable](I16(math.MinInt16)), expect: []byte{0x0, 0x00, 0x80}},
+		{label: "Encode Result(false, U32(max))", input: Ok[Encodable, Encodable](U32(math.MaxUint32)), expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff}},
+		{label: "Encode Result(false, I32(min))", input: Ok[Encodable, Encodable](I32(math.MinInt32)), expect: []byte{0x0, 0x0, 0x0, 0x0, 0x80}},
+		{label: "Encode Result(false, U64(max))", input: Ok[Encodable, Encodable](U64(math.MaxUint64)), expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{label: "Encode Result(false, I64(min))", input: Ok[Encodable, Encodable](I64(math.MinInt64)), expect: []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
+		{label: "Encode Result(true, I64(min))", input: Err[Encodable, Encodable](I64(math.MinInt64)), expect: []byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
+		{label: "Encode Result(false, U128(max))", input: Ok[Encodable, Encodable](U128{math.MaxUint64, math.MaxUint64}), expect: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{label: "Encode Result(true, I128(min)", input: Err[Encodable, Encodable](I128{U64(0), U64(math.MaxInt64 + 1)}), expect: []byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}},
+		{label: "Encode Result(false, Compact(MaxUint64)", input: Ok[Encodable, Encodable](ToCompact(uint64(math.MaxUint64))), expect: []byte{0x0, 0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+
+		{label: "Encode Result(false, empty Seq[U8])", input: Ok[Encodable, Encodable](Sequence[U8]{}), expect: []byte{0x0, 0x0}},
+		{label: "Encode Result(false, Seq[U8])", input: Ok[Encodable, Encodable](Sequence[U8]{42}), expect: []byte{0x0, 0x4, 0x2a}},
+		{label: "Encode Result(false, Result(false, Seq[U8])", input: Ok[Encodable, Encodable](Err[Encodable, Encodable](Sequence[U8]{42, 43})), expect: []byte{0x0, 0x1, 0x8, 0x2a, 0x2b}},
 	}
 
 	for _, e := range examples {
@@ -57,27 +57,22 @@ func Test_DecodeResult_ValidValue(t *testing.T) {
 	input := []byte{0, 10}
 	buffer := bytes.NewBuffer(input)
 
-	expect := Result[Encodable]{
-		HasError: false,
-		Value:    U8(10),
-	}
-
-	result, err := DecodeResult(buffer, DecodeU8, DecodeStr)
+	result, err := DecodeResult[U8, Str](buffer)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expect, result)
+	assert.Equal(t, Ok[U8, Str](10), result)
 	assert.Equal(t, 0, buffer.Len())
 }
 
 func Test_DecodeResult_ValidValue_Fails_EOF(t *testing.T) {
-	// encoded Result consists of (true, U16)
+	// encoded Result consists of (false, Compact)
 	input := []byte{0}
 	buffer := bytes.NewBuffer(input)
 
-	result, err := DecodeResult(buffer, DecodeCompact[U128], DecodeU16)
+	result, err := DecodeResult[Compact, U16](buffer)
 
-	assert.Equal(t, io.EOF, err)
-	assert.Equal(t, Result[Encodable]{}, result)
+	assert.Equal(t, &DecodeError{Type: "Result", Offset: -1, Err: io.ErrUnexpectedEOF}, err)
+	assert.Equal(t, Result[Compact, U16]{}, result)
 }
 
 func Test_DecodeResult_Error(t *testing.T) {
@@ -85,15 +80,10 @@ func Test_DecodeResult_Error(t *testing.T) {
 	input := []byte{1, 10, 0}
 	buffer := bytes.NewBuffer(input)
 
-	expect := Result[Encodable]{
-		HasError: true,
-		Value:    U16(10),
-	}
-
-	result, err := DecodeResult(buffer, DecodeCompact[U128], DecodeU16)
+	result, err := DecodeResult[Compact, U16](buffer)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expect, result)
+	assert.Equal(t, Err[Compact, U16](10), result)
 	assert.Equal(t, 0, buffer.Len())
 }
 
@@ -102,10 +92,10 @@ func Test_DecodeResult_ErrorValue_Fails_EOF(t *testing.T) {
 	input := []byte{1}
 	buffer := bytes.NewBuffer(input)
 
-	result, err := DecodeResult(buffer, DecodeCompact[U16], DecodeU16)
+	result, err := DecodeResult[CompactOf[U16], U16](buffer)
 
-	assert.Equal(t, io.EOF, err)
-	assert.Equal(t, Result[Encodable]{}, result)
+	assert.Equal(t, &DecodeError{Type: "Result", Offset: -1, Err: io.ErrUnexpectedEOF}, err)
+	assert.Equal(t, Result[CompactOf[U16], U16]{}, result)
 }
 
 func Test_DecodeResult_CorrectLengthRead(t *testing.T) {
@@ -114,15 +104,10 @@ func Test_DecodeResult_CorrectLengthRead(t *testing.T) {
 	input := []byte{1, 128, 0, 0, 0, 127, 126}
 	buffer := bytes.NewBuffer(input)
 
-	expect := Result[Encodable]{
-		HasError: true,
-		Value:    U32(128),
-	}
-
-	result, err := DecodeResult(buffer, DecodeCompact[U32], DecodeU32)
+	result, err := DecodeResult[CompactOf[U32], U32](buffer)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expect, result)
+	assert.Equal(t, Err[CompactOf[U32], U32](128), result)
 	assert.Equal(t, 2, buffer.Len())
 	assert.Equal(t, []byte{127, 126}, buffer.Bytes())
 }
@@ -141,8 +126,57 @@ func Test_DecodeResult_Fails_InvalidFirstByte(t *testing.T) {
 			buffer := &bytes.Buffer{}
 			buffer.Write(testExample.input)
 
-			_, err := DecodeResult(buffer, DecodeBool, DecodeU8)
+			_, err := DecodeResult[Bool, U8](buffer)
 			assert.ErrorIs(t, errInvalidBoolRepresentation, err)
 		})
 	}
 }
+
+func Test_Result_Accessors(t *testing.T) {
+	ok := Ok[U32, Str](5)
+	failed := Err[U32, Str]("failed")
+
+	assert.True(t, ok.IsOk())
+	assert.False(t, ok.IsErr())
+	assert.Equal(t, U32(5), ok.Unwrap())
+	assert.PanicsWithValue(t, errUnwrapOk, func() { ok.UnwrapErr() })
+
+	assert.False(t, failed.IsOk())
+	assert.True(t, failed.IsErr())
+	assert.Equal(t, Str("failed"), failed.UnwrapErr())
+	assert.PanicsWithValue(t, errUnwrapErr, func() { failed.Unwrap() })
+}
+
+func Test_MapResult(t *testing.T) {
+	double := func(value U32) U64 { return U64(value) * 2 }
+
+	assert.Equal(t, Ok[U64, Str](10), MapResult(Ok[U32, Str](5), double))
+	assert.Equal(t, Err[U64, Str]("failed"), MapResult(Err[U32, Str]("failed"), double))
+}
+
+func Test_Result_Decode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Result[Sequence[U8], Str]
+		expect []byte
+	}{
+		{label: "Ok", input: Ok[Sequence[U8], Str](Sequence[U8]{1, 2}), expect: []byte{0x00, 0x08, 0x01, 0x02}},
+		{label: "Err", input: Err[Sequence[U8], Str]("ab"), expect: []byte{0x01, 0x08, 0x61, 0x62}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Equal(t, e.expect, e.input.Bytes())
+			assert.Equal(t, len(e.expect), e.input.EncodedLen())
+
+			var result Result[Sequence[U8], Str]
+			err := result.Decode(bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, result)
+
+			decoded, err := decodeByType(Result[Sequence[U8], Str]{}, bytes.NewBuffer(e.expect))
+			assert.NoError(t, err)
+			assert.Equal(t, e.input, decoded)
+		})
+	}
+}
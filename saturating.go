@@ -0,0 +1,186 @@
+package goscale
+
+/*
+	Saturating and wrapping arithmetic mirroring Rust's saturating_* and wrapping_* integer methods.
+	The saturating operations clamp the result to the bounds of the type,
+	the wrapping operations discard the bits that do not fit in it.
+*/
+
+import (
+	"math"
+)
+
+func SaturatingAdd[T CheckedInteger](a, b T) T {
+	return saturate(CheckedAdd(a, b))
+}
+
+func SaturatingSub[T CheckedInteger](a, b T) T {
+	return saturate(CheckedSub(a, b))
+}
+
+func SaturatingMul[T CheckedInteger](a, b T) T {
+	return saturate(CheckedMul(a, b))
+}
+
+// SaturatingDiv only saturates the signed minimum divided by -1, dividing by zero panics like Rust does.
+func SaturatingDiv[T CheckedInteger](a, b T) T {
+	quotient, err := CheckedDiv(a, b)
+	if err == errDivisionByZero {
+		panic(errDivisionByZero)
+	}
+	return saturate(quotient, err)
+}
+
+func SaturatingPow[T CheckedInteger](base T, exp uint32) T {
+	return saturate(CheckedPow(base, exp))
+}
+
+func WrappingAdd[T CheckedInteger](a, b T) T {
+	switch a := any(a).(type) {
+	case U8:
+		return any(a + any(b).(U8)).(T)
+	case U16:
+		return any(a + any(b).(U16)).(T)
+	case U32:
+		return any(a + any(b).(U32)).(T)
+	case U64:
+		return any(a + any(b).(U64)).(T)
+	case U128:
+		return any(a.Add(any(b).(U128))).(T)
+	case U256:
+		return any(a.Add(any(b).(U256))).(T)
+	case I8:
+		return any(a + any(b).(I8)).(T)
+	case I16:
+		return any(a + any(b).(I16)).(T)
+	case I32:
+		return any(a + any(b).(I32)).(T)
+	case I64:
+		return any(a + any(b).(I64)).(T)
+	case I256:
+		return any(a.Add(any(b).(I256))).(T)
+	default:
+		return any(any(a).(I128).Add(any(b).(I128))).(T)
+	}
+}
+
+func WrappingSub[T CheckedInteger](a, b T) T {
+	switch a := any(a).(type) {
+	case U8:
+		return any(a - any(b).(U8)).(T)
+	case U16:
+		return any(a - any(b).(U16)).(T)
+	case U32:
+		return any(a - any(b).(U32)).(T)
+	case U64:
+		return any(a - any(b).(U64)).(T)
+	case U128:
+		return any(a.Sub(any(b).(U128))).(T)
+	case U256:
+		return any(a.Sub(any(b).(U256))).(T)
+	case I8:
+		return any(a - any(b).(I8)).(T)
+	case I16:
+		return any(a - any(b).(I16)).(T)
+	case I32:
+		return any(a - any(b).(I32)).(T)
+	case I64:
+		return any(a - any(b).(I64)).(T)
+	case I256:
+		return any(a.Sub(any(b).(I256))).(T)
+	default:
+		return any(any(a).(I128).Sub(any(b).(I128))).(T)
+	}
+}
+
+func WrappingMul[T CheckedInteger](a, b T) T {
+	switch a := any(a).(type) {
+	case U8:
+		return any(a * any(b).(U8)).(T)
+	case U16:
+		return any(a * any(b).(U16)).(T)
+	case U32:
+		return any(a * any(b).(U32)).(T)
+	case U64:
+		return any(a * any(b).(U64)).(T)
+	case U128:
+		return any(a.Mul(any(b).(U128))).(T)
+	case U256:
+		return any(a.Mul(any(b).(U256))).(T)
+	case I8:
+		return any(a * any(b).(I8)).(T)
+	case I16:
+		return any(a * any(b).(I16)).(T)
+	case I32:
+		return any(a * any(b).(I32)).(T)
+	case I64:
+		return any(a * any(b).(I64)).(T)
+	case I256:
+		return any(a.Mul(any(b).(I256))).(T)
+	default:
+		return any(any(a).(I128).Mul(any(b).(I128))).(T)
+	}
+}
+
+// saturate clamps the result of a checked operation to the bounds of T.
+func saturate[T CheckedInteger](value T, err error) T {
+	switch err {
+	case errOverflow:
+		return maxInteger[T]()
+	case errUnderflow:
+		return minInteger[T]()
+	default:
+		return value
+	}
+}
+
+func maxInteger[T CheckedInteger]() T {
+	var max T
+	switch v := any(&max).(type) {
+	case *U8:
+		*v = math.MaxUint8
+	case *U16:
+		*v = math.MaxUint16
+	case *U32:
+		*v = math.MaxUint32
+	case *U64:
+		*v = math.MaxUint64
+	case *U128:
+		*v = MaxU128()
+	case *U256:
+		*v = MaxU256()
+	case *I8:
+		*v = math.MaxInt8
+	case *I16:
+		*v = math.MaxInt16
+	case *I32:
+		*v = math.MaxInt32
+	case *I64:
+		*v = math.MaxInt64
+	case *I128:
+		*v = MaxI128()
+	case *I256:
+		*v = MaxI256()
+	}
+	return max
+}
+
+func minInteger[T CheckedInteger]() T {
+	var min T
+	switch v := any(&min).(type) {
+	case *I8:
+		*v = math.MinInt8
+	case *I16:
+		*v = math.MinInt16
+	case *I32:
+		*v = math.MinInt32
+	case *I64:
+		*v = math.MinInt64
+	case *I128:
+		*v = MinI128()
+	case *I256:
+		*v = MinI256()
+	}
+	// the unsigned minimum is zero
+	return min
+}
@@ -0,0 +1,238 @@
+package goscale
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clamp returns the exact result clamped to [min, max].
+func clamp(exact, min, max *big.Int) *big.Int {
+	if exact.Cmp(max) > 0 {
+		return max
+	}
+	if exact.Cmp(min) < 0 {
+		return min
+	}
+	return exact
+}
+
+// wrap returns the exact result modulo 2^bitSize in the range of the signed or unsigned type.
+func wrap(exact *big.Int, bitSize uint, signed bool) *big.Int {
+	modulus := new(big.Int).Lsh(big.NewInt(1), bitSize)
+	result := new(big.Int).Mod(exact, modulus)
+	if signed && result.Bit(int(bitSize)-1) == 1 {
+		result.Sub(result, modulus)
+	}
+	return result
+}
+
+func Test_Saturating_Wrapping_I8_Exhaustive(t *testing.T) {
+	min, max := big.NewInt(math.MinInt8), big.NewInt(math.MaxInt8)
+
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			x, y := I8(a), I8(b)
+			sum, diff, product := big.NewInt(int64(a+b)), big.NewInt(int64(a-b)), big.NewInt(int64(a*b))
+
+			assert.Equal(t, I8(clamp(sum, min, max).Int64()), SaturatingAdd(x, y))
+			assert.Equal(t, I8(clamp(diff, min, max).Int64()), SaturatingSub(x, y))
+			assert.Equal(t, I8(clamp(product, min, max).Int64()), SaturatingMul(x, y))
+
+			assert.Equal(t, I8(wrap(sum, 8, true).Int64()), WrappingAdd(x, y))
+			assert.Equal(t, I8(wrap(diff, 8, true).Int64()), WrappingSub(x, y))
+			assert.Equal(t, I8(wrap(product, 8, true).Int64()), WrappingMul(x, y))
+		}
+	}
+}
+
+func Test_Saturating_Wrapping_U8_Exhaustive(t *testing.T) {
+	min, max := big.NewInt(0), big.NewInt(math.MaxUint8)
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			x, y := U8(a), U8(b)
+			sum, diff, product := big.NewInt(int64(a+b)), big.NewInt(int64(a-b)), big.NewInt(int64(a*b))
+
+			assert.Equal(t, U8(clamp(sum, min, max).Int64()), SaturatingAdd(x, y))
+			assert.Equal(t, U8(clamp(diff, min, max).Int64()), SaturatingSub(x, y))
+			assert.Equal(t, U8(clamp(product, min, max).Int64()), SaturatingMul(x, y))
+
+			assert.Equal(t, U8(wrap(sum, 8, false).Int64()), WrappingAdd(x, y))
+			assert.Equal(t, U8(wrap(diff, 8, false).Int64()), WrappingSub(x, y))
+			assert.Equal(t, U8(wrap(product, 8, false).Int64()), WrappingMul(x, y))
+		}
+	}
+}
+
+func Test_Saturating_Boundaries(t *testing.T) {
+	testExamples := []struct {
+		label  string
+		result any
+		expect any
+	}{
+		{"U16 Max+1", SaturatingAdd(U16(math.MaxUint16), 1), U16(math.MaxUint16)},
+		{"U16 0-1", SaturatingSub(U16(0), 1), U16(0)},
+		{"U32 Max*2", SaturatingMul(U32(math.MaxUint32), 2), U32(math.MaxUint32)},
+		{"U64 Max+Max", SaturatingAdd(U64(math.MaxUint64), math.MaxUint64), U64(math.MaxUint64)},
+		{"U64 1-Max", SaturatingSub(U64(1), math.MaxUint64), U64(0)},
+		{"I16 Min-1", SaturatingSub(I16(math.MinInt16), 1), I16(math.MinInt16)},
+		{"I16 Max-(-1)", SaturatingSub(I16(math.MaxInt16), -1), I16(math.MaxInt16)},
+		{"I32 Min*-1", SaturatingMul(I32(math.MinInt32), -1), I32(math.MaxInt32)},
+		{"I32 Max*-2", SaturatingMul(I32(math.MaxInt32), -2), I32(math.MinInt32)},
+		{"I64 Min+Min", SaturatingAdd(I64(math.MinInt64), math.MinInt64), I64(math.MinInt64)},
+		{"I64 Max+Min", SaturatingAdd(I64(math.MaxInt64), math.MinInt64), I64(-1)},
+		{"U128 Max+1", MaxU128().SaturatingAdd(NewU128(1)), MaxU128()},
+		{"U128 0-1", NewU128(0).SaturatingSub(NewU128(1)), NewU128(0)},
+		{"U128 2^64-1", U128{0, 1}.SaturatingSub(NewU128(1)), U128{math.MaxUint64, 0}},
+		{"U128 Max*2", MaxU128().SaturatingMul(NewU128(2)), MaxU128()},
+		{"U128 2^64*2^64", U128{0, 1}.SaturatingMul(U128{0, 1}), MaxU128()},
+		{"U128 2^63*2", U128{1 << 63}.SaturatingMul(NewU128(2)), U128{0, 1}},
+		{"I128 Max+1", MaxI128().SaturatingAdd(NewI128(1)), MaxI128()},
+		{"I128 Min+(-1)", MinI128().SaturatingAdd(NewI128(-1)), MinI128()},
+		{"I128 Min-1", MinI128().SaturatingSub(NewI128(1)), MinI128()},
+		{"I128 0-Min", NewI128(0).SaturatingSub(MinI128()), MaxI128()},
+		{"I128 Min*-1", MinI128().SaturatingMul(NewI128(-1)), MaxI128()},
+		{"I128 Max*-2", MaxI128().SaturatingMul(NewI128(-2)), MinI128()},
+		{"I128 -2^63*2^64", NewI128(math.MinInt64).SaturatingMul(I128{0, 1}), MinI128()},
+		{"I128 -2^63*-2^64", NewI128(math.MinInt64).SaturatingMul(NewI128(-1).Mul(I128{0, 1})), MaxI128()},
+		{"I128 -3*5", NewI128(-3).SaturatingMul(NewI128(5)), NewI128(-15)},
+		{"U256 Max+1", SaturatingAdd(MaxU256(), NewU256(1)), MaxU256()},
+		{"U256 0-1", SaturatingSub(NewU256(0), NewU256(1)), NewU256(0)},
+		{"I256 Min-1", SaturatingSub(MinI256(), NewI256(1)), MinI256()},
+		{"I256 Max*-2", SaturatingMul(MaxI256(), NewI256(-2)), MinI256()},
+		{"U8 7/2", SaturatingDiv(U8(7), 2), U8(3)},
+		{"I8 Min/-1", SaturatingDiv(I8(math.MinInt8), -1), I8(math.MaxInt8)},
+		{"I8 -7/2", SaturatingDiv(I8(-7), 2), I8(-3)},
+		{"I128 Min/-1", SaturatingDiv(MinI128(), NewI128(-1)), MaxI128()},
+		{"U256 Max/2", SaturatingDiv(MaxU256(), NewU256(2)), U256(MaxI256())},
+		{"I256 Min/-1", SaturatingDiv(MinI256(), NewI256(-1)), MaxI256()},
+		{"I256 -7/2", SaturatingDiv(NewI256(-7), NewI256(2)), NewI256(-3)},
+		{"U8 2^8", SaturatingPow(U8(2), 8), U8(math.MaxUint8)},
+		{"I8 -2^7", SaturatingPow(I8(-2), 7), I8(math.MinInt8)},
+		{"I16 -2^17", SaturatingPow(I16(-2), 17), I16(math.MinInt16)},
+		{"I16 -2^18", SaturatingPow(I16(-2), 18), I16(math.MaxInt16)},
+		{"U128 10^39", SaturatingPow(NewU128(10), 39), MaxU128()},
+		{"U256 10^78", SaturatingPow(NewU256(10), 78), MaxU256()},
+		{"U256 2^255", SaturatingPow(NewU256(2), 255), U256{0, 0, 0, 1 << 63}},
+		{"I256 -3^161", SaturatingPow(NewI256(-3), 161), MinI256()},
+		{"I256 -3^162", SaturatingPow(NewI256(-3), 162), MaxI256()},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expect, testExample.result)
+		})
+	}
+}
+
+func Test_Wrapping_Boundaries(t *testing.T) {
+	testExamples := []struct {
+		label  string
+		result any
+		expect any
+	}{
+		{"U16 Max+1", WrappingAdd(U16(math.MaxUint16), 1), U16(0)},
+		{"U32 0-1", WrappingSub(U32(0), 1), U32(math.MaxUint32)},
+		{"U64 Max*Max", WrappingMul(U64(math.MaxUint64), math.MaxUint64), U64(1)},
+		{"I16 Max+1", WrappingAdd(I16(math.MaxInt16), 1), I16(math.MinInt16)},
+		{"I32 Min-1", WrappingSub(I32(math.MinInt32), 1), I32(math.MaxInt32)},
+		{"I64 Min*-1", WrappingMul(I64(math.MinInt64), -1), I64(math.MinInt64)},
+		{"U128 Max+1", MaxU128().WrappingAdd(NewU128(1)), NewU128(0)},
+		{"U128 0-1", NewU128(0).WrappingSub(NewU128(1)), MaxU128()},
+		{"U128 Max*Max", WrappingMul(MaxU128(), MaxU128()), NewU128(1)},
+		{"I128 Max+1", MaxI128().WrappingAdd(NewI128(1)), MinI128()},
+		{"I128 Min-1", WrappingSub(MinI128(), NewI128(1)), MaxI128()},
+		{"I128 Min*-1", MinI128().WrappingMul(NewI128(-1)), MinI128()},
+		{"I128 Max*2", MaxI128().WrappingMul(NewI128(2)), NewI128(-2)},
+		{"U256 Max+1", WrappingAdd(MaxU256(), NewU256(1)), NewU256(0)},
+		{"U256 0-1", WrappingSub(NewU256(0), NewU256(1)), MaxU256()},
+		{"U256 Max*Max", WrappingMul(MaxU256(), MaxU256()), NewU256(1)},
+		{"I256 Max+1", WrappingAdd(MaxI256(), NewI256(1)), MinI256()},
+		{"I256 Min-1", WrappingSub(MinI256(), NewI256(1)), MaxI256()},
+		{"I256 Min*-1", WrappingMul(MinI256(), NewI256(-1)), MinI256()},
+		{"I256 Max*2", WrappingMul(MaxI256(), NewI256(2)), NewI256(-2)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expect, testExample.result)
+		})
+	}
+}
+
+func Test_Saturating_Wrapping_128_MatchesBigInt(t *testing.T) {
+	minU128, maxU128 := big.NewInt(0), MaxU128().ToBigInt()
+	minI128, maxI128 := MinI128().ToBigInt(), MaxI128().ToBigInt()
+
+	operands := u128Operands()
+	for _, a := range operands {
+		for _, b := range operands {
+			x, y := a.ToBigInt(), b.ToBigInt()
+			sum, diff, product := new(big.Int).Add(x, y), new(big.Int).Sub(x, y), new(big.Int).Mul(x, y)
+
+			assert.Equal(t, bigIntToU128(clamp(sum, minU128, maxU128)), a.SaturatingAdd(b))
+			assert.Equal(t, bigIntToU128(clamp(diff, minU128, maxU128)), a.SaturatingSub(b))
+			assert.Equal(t, bigIntToU128(clamp(product, minU128, maxU128)), a.SaturatingMul(b))
+			assert.Equal(t, bigIntToU128(wrap(sum, 128, false)), a.WrappingAdd(b))
+			assert.Equal(t, bigIntToU128(wrap(diff, 128, false)), a.WrappingSub(b))
+			assert.Equal(t, bigIntToU128(wrap(product, 128, false)), a.WrappingMul(b))
+
+			sa, sb := I128(a), I128(b)
+			x, y = sa.ToBigInt(), sb.ToBigInt()
+			sum, diff, product = new(big.Int).Add(x, y), new(big.Int).Sub(x, y), new(big.Int).Mul(x, y)
+
+			assert.Equal(t, bigIntToI128(clamp(sum, minI128, maxI128)), sa.SaturatingAdd(sb))
+			assert.Equal(t, bigIntToI128(clamp(diff, minI128, maxI128)), sa.SaturatingSub(sb))
+			assert.Equal(t, bigIntToI128(clamp(product, minI128, maxI128)), sa.SaturatingMul(sb))
+			assert.Equal(t, bigIntToI128(wrap(sum, 128, true)), sa.WrappingAdd(sb))
+			assert.Equal(t, bigIntToI128(wrap(diff, 128, true)), sa.WrappingSub(sb))
+			assert.Equal(t, bigIntToI128(wrap(product, 128, true)), sa.WrappingMul(sb))
+		}
+	}
+}
+
+func Test_SaturatingDiv_ByZero(t *testing.T) {
+	assert.PanicsWithValue(t, errDivisionByZero, func() { SaturatingDiv(U32(1), 0) })
+	assert.PanicsWithValue(t, errDivisionByZero, func() { SaturatingDiv(NewI128(1), NewI128(0)) })
+	assert.PanicsWithValue(t, errDivisionByZero, func() { SaturatingDiv(NewU256(1), NewU256(0)) })
+}
+
+func Test_Saturating_Wrapping_256_MatchesBigInt(t *testing.T) {
+	minU256, maxU256 := big.NewInt(0), MaxU256().ToBigInt()
+	minI256, maxI256 := MinI256().ToBigInt(), MaxI256().ToBigInt()
+
+	operands := u256Operands()
+	for _, a := range operands {
+		for _, b := range operands {
+			x, y := a.ToBigInt(), b.ToBigInt()
+			sum, diff, product := new(big.Int).Add(x, y), new(big.Int).Sub(x, y), new(big.Int).Mul(x, y)
+
+			assert.Equal(t, bigIntToU256(clamp(sum, minU256, maxU256)), SaturatingAdd(a, b))
+			assert.Equal(t, bigIntToU256(clamp(diff, minU256, maxU256)), SaturatingSub(a, b))
+			assert.Equal(t, bigIntToU256(clamp(product, minU256, maxU256)), SaturatingMul(a, b))
+			assert.Equal(t, bigIntToU256(wrap(sum, 256, false)), WrappingAdd(a, b))
+			assert.Equal(t, bigIntToU256(wrap(diff, 256, false)), WrappingSub(a, b))
+			assert.Equal(t, bigIntToU256(wrap(product, 256, false)), WrappingMul(a, b))
+			if b != (U256{}) {
+				assert.Equal(t, bigIntToU256(new(big.Int).Quo(x, y)), SaturatingDiv(a, b))
+			}
+
+			sa, sb := I256(a), I256(b)
+			x, y = sa.ToBigInt(), sb.ToBigInt()
+			sum, diff, product = new(big.Int).Add(x, y), new(big.Int).Sub(x, y), new(big.Int).Mul(x, y)
+
+			assert.Equal(t, bigIntToI256(clamp(sum, minI256, maxI256)), SaturatingAdd(sa, sb))
+			assert.Equal(t, bigIntToI256(clamp(diff, minI256, maxI256)), SaturatingSub(sa, sb))
+			assert.Equal(t, bigIntToI256(clamp(product, minI256, maxI256)), SaturatingMul(sa, sb))
+			assert.Equal(t, bigIntToI256(wrap(sum, 256, true)), WrappingAdd(sa, sb))
+			assert.Equal(t, bigIntToI256(wrap(diff, 256, true)), WrappingSub(sa, sb))
+			assert.Equal(t, bigIntToI256(wrap(product, 256, true)), WrappingMul(sa, sb))
+			if sb != (I256{}) {
+				assert.Equal(t, bigIntToI256(clamp(new(big.Int).Quo(x, y), minI256, maxI256)), SaturatingDiv(sa, sb))
+			}
+		}
+	}
+}
@@ -8,22 +8,34 @@ package goscale
 */
 
 import (
-	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+)
+
+var (
+	errFixedSequenceLength = errors.New("fixed sequence length mismatch")
+	errFixedSequenceNoLen  = errors.New("fixed sequence has no length, use FixedSequenceOf")
 )
 
 type Sequence[T Encodable] []T
 
-func (seq Sequence[T]) Encode(buffer *bytes.Buffer) error {
-	err := ToCompact(len(seq)).Encode(buffer)
+func (seq Sequence[T]) Encode(writer io.Writer) error {
+	if u8s, ok := any(seq).(Sequence[U8]); ok {
+		return Bytes(SequenceU8ToBytes(u8s)).Encode(writer)
+	}
+
+	err := ToCompact(len(seq)).Encode(writer)
 	if err != nil {
 		return err
 	}
 
 	for _, v := range seq {
 		//if reflect.TypeOf(v).Kind() == reflect.Struct {
-		//	EncodeTuple(v, buffer)
+		//	EncodeTuple(v, writer)
 		//} else {
-		err := v.Encode(buffer)
+		err := v.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -37,48 +49,106 @@ func (seq Sequence[T]) Bytes() []byte {
 	return EncodedBytes(seq)
 }
 
-func DecodeSequence[T Encodable](buffer *bytes.Buffer) (Sequence[T], error) {
-	size, err := DecodeCompact[U128](buffer)
+func (seq Sequence[T]) EncodedLen() int {
+	return compactEncodedLen(uint64(len(seq))) + FixedSequence[T](seq).EncodedLen()
+}
+
+func DecodeSequence[T Encodable](reader io.Reader) (Sequence[T], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return Sequence[T]{}, err
+	}
+	return decodeSequence[T](reader, size)
+}
+
+// decodeSequence decodes the elements following the length prefix.
+func decodeSequence[T Encodable](reader io.Reader, size int) (Sequence[T], error) {
+	err := descend(reader)
+	if err != nil {
+		return Sequence[T]{}, err
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, size, reflect.TypeOf(*new(T)))
 	if err != nil {
 		return Sequence[T]{}, err
 	}
-	v := size.ToBigInt()
-	values := make([]T, v.Int64())
 
-	for i := 0; i < len(values); i++ {
-		t, err := decodeByType(*new(T), buffer)
+	if _, ok := any(*new(T)).(U8); ok {
+		u8s, err := decodeSliceU8(reader, size)
 		if err != nil {
 			return Sequence[T]{}, err
 		}
-		values[i] = t.(T)
+		return any(Sequence[U8](u8s)).(Sequence[T]), nil
+	}
+
+	values := make([]T, 0, preallocate(reader, size))
+	for i := 0; i < size; i++ {
+		t, err := decodeInto[T](reader)
+		if err != nil {
+			return Sequence[T]{}, Decoder{Reader: reader, Type: "Sequence"}.Truncated(err)
+		}
+		values = append(values, t)
 	}
 	return values, nil
 }
 
-func DecodeSequenceWith[T Encodable](buffer *bytes.Buffer, decodeFunc func(buffer *bytes.Buffer) (T, error)) (Sequence[T], error) {
-	size, err := DecodeCompact[U128](buffer)
+func (seq *Sequence[T]) Decode(reader io.Reader) error {
+	result, err := DecodeSequence[T](reader)
+	if err != nil {
+		return err
+	}
+	*seq = result
+	return nil
+}
+
+func DecodeSequenceWith[T Encodable](reader io.Reader, decodeFunc func(reader io.Reader) (T, error)) (Sequence[T], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return Sequence[T]{}, err
+	}
+
+	err = descend(reader)
 	if err != nil {
 		return Sequence[T]{}, err
 	}
-	v := size.ToBigInt()
-	values := make([]T, v.Int64())
+	defer ascend(reader)
 
-	for i := 0; i < len(values); i++ {
-		dec, err := decodeFunc(buffer)
+	err = checkZeroSized(reader, size, reflect.TypeOf(*new(T)))
+	if err != nil {
+		return Sequence[T]{}, err
+	}
+
+	values := make([]T, 0, preallocate(reader, size))
+	for i := 0; i < size; i++ {
+		dec, err := decodeFunc(reader)
 		if err != nil {
-			return Sequence[T]{}, err
+			return Sequence[T]{}, Decoder{Reader: reader, Type: "Sequence"}.Truncated(err)
 		}
-		values[i] = dec
+		values = append(values, dec)
 	}
 	return values, nil
 }
 
-func DecodeSliceU8(buffer *bytes.Buffer) ([]U8, error) {
-	sequence, err := DecodeSequence[U8](buffer)
+func DecodeSliceU8(reader io.Reader) ([]U8, error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return make([]U8, 0), err
+	}
+	return decodeSliceU8(reader, size)
+}
+
+func decodeSliceU8(reader io.Reader, size int) ([]U8, error) {
+	bytes, err := readBytes(reader, size, "Sequence[U8]")
 	if err != nil {
 		return make([]U8, 0), err
 	}
-	return sequence, nil
+
+	values := make([]U8, len(bytes))
+	for i, v := range bytes {
+		values[i] = U8(v)
+	}
+	return values, nil
 }
 
 type FixedSequence[T Encodable] []T // TODO: https://github.com/LimeChain/goscale/issues/37
@@ -96,12 +166,16 @@ func NewFixedSequence[T Encodable](size int, values ...T) FixedSequence[T] {
 	return fseq
 }
 
-func (fseq FixedSequence[T]) Encode(buffer *bytes.Buffer) error {
+func (fseq FixedSequence[T]) Encode(writer io.Writer) error {
+	if u8s, ok := any(fseq).(FixedSequence[U8]); ok {
+		return Encoder{Writer: writer}.Write(FixedSequenceU8ToBytes(u8s))
+	}
+
 	for _, v := range fseq {
 		//if reflect.TypeOf(v).Kind() == reflect.Struct {
-		//	EncodeTuple(v, buffer)
+		//	EncodeTuple(v, writer)
 		//} else {
-		err := v.Encode(buffer)
+		err := v.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -114,14 +188,220 @@ func (fseq FixedSequence[T]) Bytes() []byte {
 	return EncodedBytes(fseq)
 }
 
-func DecodeFixedSequence[T Encodable](size int, buffer *bytes.Buffer) (FixedSequence[T], error) {
+func (fseq FixedSequence[T]) EncodedLen() int {
+	if _, ok := any(fseq).(FixedSequence[U8]); ok {
+		return len(fseq)
+	}
+
+	size := 0
+	for _, v := range fseq {
+		size += EncodedLen(v)
+	}
+	return size
+}
+
+// allows reflection based codecs to tell FixedSequence[T] apart from Sequence[T]
+func (fseq FixedSequence[T]) fixedSequence() {}
+
+func DecodeFixedSequence[T Encodable](size int, reader io.Reader) (FixedSequence[T], error) {
+	err := descend(reader)
+	if err != nil {
+		return FixedSequence[T]{}, err
+	}
+	defer ascend(reader)
+
+	if _, ok := any(*new(T)).(U8); ok {
+		u8s, err := decodeSliceU8(reader, size)
+		if decodeErr, ok := err.(*DecodeError); ok && decodeErr.Read == 0 {
+			// without a length prefix nothing is consumed, like in Decoder.Read
+			return FixedSequence[T]{}, io.EOF
+		}
+		if err != nil {
+			return FixedSequence[T]{}, err
+		}
+		return any(FixedSequence[U8](u8s)).(FixedSequence[T]), nil
+	}
+
+	zeroSized := isZeroSized(reflect.TypeOf(*new(T)))
 	result := make([]T, size)
 	for i := 0; i < size; i++ {
-		t, err := decodeByType(*new(T), buffer)
+		t, err := decodeInto[T](reader)
+		if err != nil && i > 0 && !zeroSized {
+			return FixedSequence[T]{}, Decoder{Reader: reader, Type: "FixedSequence"}.Truncated(err)
+		}
 		if err != nil {
 			return FixedSequence[T]{}, err
 		}
-		result[i] = t.(T)
+		result[i] = t
+	}
+	return result, nil
+}
+
+// Decode reads as many elements as the sequence is allocated with. It fails if the sequence
+// is empty, like the zero values decoded as elements, FixedSequenceOf has the length in its type.
+func (fseq *FixedSequence[T]) Decode(reader io.Reader) error {
+	if len(*fseq) == 0 {
+		return errFixedSequenceNoLen
+	}
+	result, err := DecodeFixedSequence[T](len(*fseq), reader)
+	if err != nil {
+		return err
+	}
+	*fseq = result
+	return nil
+}
+
+// FixedLength provides the length of FixedSequenceOf[T, L], it is implemented
+// by an empty type, e.g. `type Len4 struct{}` with `func (Len4) Length() int { return 4 }`.
+type FixedLength interface {
+	Length() int
+}
+
+// FixedSequenceOf is a FixedSequence[T] whose length is part of its type, it is
+// checked when encoding and it is the number of decoded elements.
+type FixedSequenceOf[T Encodable, L FixedLength] []T
+
+// NewFixedSequenceOf fails if the number of values is not the length of L.
+func NewFixedSequenceOf[T Encodable, L FixedLength](values ...T) (FixedSequenceOf[T, L], error) {
+	fseq := FixedSequenceOf[T, L](values)
+	if len(fseq) != fseq.fixedLength() {
+		return nil, errFixedSequenceLength
+	}
+	return fseq, nil
+}
+
+func (fseq FixedSequenceOf[T, L]) Encode(writer io.Writer) error {
+	if len(fseq) != fseq.fixedLength() {
+		return errFixedSequenceLength
+	}
+	return FixedSequence[T](fseq).Encode(writer)
+}
+
+func (fseq FixedSequenceOf[T, L]) Bytes() []byte {
+	return EncodedBytes(fseq)
+}
+
+func (fseq FixedSequenceOf[T, L]) EncodedLen() int {
+	return FixedSequence[T](fseq).EncodedLen()
+}
+
+func (fseq FixedSequenceOf[T, L]) maxEncodedLen() (int, bool) {
+	n, ok := MaxEncodedLen[T]()
+	return fseq.fixedLength() * n, ok
+}
+
+func (fseq FixedSequenceOf[T, L]) fixedSequence() {}
+
+func (fseq FixedSequenceOf[T, L]) fixedLength() int {
+	return (*new(L)).Length()
+}
+
+func DecodeFixedSequenceOf[T Encodable, L FixedLength](reader io.Reader) (FixedSequenceOf[T, L], error) {
+	result, err := DecodeFixedSequence[T](FixedSequenceOf[T, L]{}.fixedLength(), reader)
+	if err != nil {
+		return nil, err
+	}
+	return FixedSequenceOf[T, L](result), nil
+}
+
+func (fseq *FixedSequenceOf[T, L]) Decode(reader io.Reader) error {
+	result, err := DecodeFixedSequenceOf[T, L](reader)
+	if err != nil {
+		return err
+	}
+	*fseq = result
+	return nil
+}
+
+// Bytes is a Sequence[U8] backed by a []byte, like Vec<u8> it is encoded
+// with a length prefix and decoded with a single read.
+type Bytes []byte
+
+func (b Bytes) Encode(writer io.Writer) error {
+	err := ToCompact(len(b)).Encode(writer)
+	if err != nil {
+		return err
+	}
+	return Encoder{Writer: writer}.Write(b)
+}
+
+func (b Bytes) Bytes() []byte {
+	return EncodedBytes(b)
+}
+
+func (b Bytes) EncodedLen() int {
+	return compactEncodedLen(uint64(len(b))) + len(b)
+}
+
+func DecodeBytes(reader io.Reader) (Bytes, error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+	return readBytes(reader, size, "Bytes")
+}
+
+// DecodeBytesAliased avoids copying the bytes when the reader has a Next method like
+// *bytes.Buffer, the result shares its memory and is valid until the next write to the buffer.
+func DecodeBytesAliased(reader io.Reader) (Bytes, error) {
+	buffer, ok := reader.(interface{ Next(n int) []byte })
+	if !ok {
+		return DecodeBytes(reader)
+	}
+
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+	err = ensureRemaining(reader, size, "Bytes")
+	if err != nil {
+		return nil, err
+	}
+
+	// the readers without Len are not checked by ensureRemaining
+	result := buffer.Next(size)
+	if len(result) != size {
+		decoder := Decoder{Reader: reader, Type: "Bytes"}
+		return nil, decoder.newDecodeError(len(result), size)
+	}
+	return result, nil
+}
+
+func (b *Bytes) Decode(reader io.Reader) error {
+	result, err := DecodeBytes(reader)
+	if err != nil {
+		return err
+	}
+	*b = result
+	return nil
+}
+
+// readBytes reads size bytes at once when the input is known to hold them, otherwise
+// in growing chunks so that a forged length does not allocate more than the input.
+func readBytes(reader io.Reader, size int, typeName string) ([]byte, error) {
+	err := ensureRemaining(reader, size, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0, preallocate(reader, size))
+	for len(result) < size {
+		if len(result) == cap(result) {
+			result = slices.Grow(result, min(size-len(result), max(len(result), maxPreallocation)))
+		}
+
+		n, err := io.ReadFull(reader, result[len(result):min(cap(result), size)])
+		result = result[:len(result)+n]
+		if err == io.EOF {
+			// the length prefix is already consumed
+			err = io.ErrUnexpectedEOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, Decoder{Reader: reader, Type: typeName}.newDecodeError(len(result), size)
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 	return result, nil
 }
@@ -129,21 +409,41 @@ func DecodeFixedSequence[T Encodable](size int, buffer *bytes.Buffer) (FixedSequ
 // additional helper type
 type Str string
 
-func (value Str) Encode(buffer *bytes.Buffer) error {
-	_, err := buffer.Write(value.Bytes())
-	return err
+func (value Str) Encode(writer io.Writer) error {
+	err := ToCompact(len(value)).Encode(writer)
+	if err != nil {
+		return err
+	}
+	return Encoder{Writer: writer}.WriteString(string(value))
 }
 
 func (value Str) Bytes() []byte {
-	return Sequence[U8](StrToSliceU8(value)).Bytes()
+	return EncodedBytes(value)
 }
 
-func DecodeStr(buffer *bytes.Buffer) (Str, error) {
-	decodeSlice, err := DecodeSliceU8(buffer)
+func (value Str) EncodedLen() int {
+	return compactEncodedLen(uint64(len(value))) + len(value)
+}
+
+func DecodeStr(reader io.Reader) (Str, error) {
+	size, err := DecodeLength(reader)
 	if err != nil {
 		return "", err
 	}
-	return SliceU8ToStr(decodeSlice), nil
+	bytes, err := readBytes(reader, size, "Str")
+	if err != nil {
+		return "", err
+	}
+	return Str(bytes), nil
+}
+
+func (value *Str) Decode(reader io.Reader) error {
+	result, err := DecodeStr(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
 }
 
 func SliceU8ToStr(values []U8) Str {
@@ -156,8 +456,8 @@ func SliceU8ToStr(values []U8) Str {
 
 func StrToSliceU8(s Str) []U8 {
 	result := make([]U8, len(s))
-	for i, v := range []byte(s) {
-		result[i] = U8(v)
+	for i := 0; i < len(s); i++ {
+		result[i] = U8(s[i])
 	}
 	return result
 }
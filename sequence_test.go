@@ -312,15 +312,15 @@ func Test_EncodeOptionSequence(t *testing.T) {
 func Test_EncodeResultSequence(t *testing.T) {
 	var testExamples = []struct {
 		label       string
-		input       Sequence[Result[U8]]
+		input       Sequence[Result[U8, U8]]
 		expectation []byte
 	}{
 		{
 			label: "([Result(true, 1),Result(true, 3),Result(true, 5)])",
-			input: Sequence[Result[U8]]{
-				Result[U8]{true, 1},
-				Result[U8]{true, 3},
-				Result[U8]{true, 5},
+			input: Sequence[Result[U8, U8]]{
+				Err[U8, U8](1),
+				Err[U8, U8](3),
+				Err[U8, U8](5),
 			},
 			expectation: []byte{0x0c, 0x01, 0x01, 0x01, 0x03, 0x01, 0x05},
 		},
@@ -560,6 +560,209 @@ func Test_DecodeFixedSequence_Empty(t *testing.T) {
 	assert.Equal(t, FixedSequence[U8]{}, result)
 }
 
+func Test_DecodeFixedSequence_NoLength(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x08, 0x01, 0x02, 0x03, 0x04})
+
+	result, err := DecodeSequence[FixedSequence[U8]](buffer)
+
+	assert.Equal(t, errFixedSequenceNoLen, err)
+	assert.Empty(t, result)
+
+	var fseq FixedSequence[U8]
+	assert.Equal(t, errFixedSequenceNoLen, fseq.Decode(bytes.NewBuffer([]byte{0x01})))
+}
+
+func Test_EncodeBytes(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Bytes
+		expect []byte
+	}{
+		{label: "Encode Bytes(empty)", input: Bytes{}, expect: []byte{0x00}},
+		{label: "Encode Bytes(abc)", input: Bytes("abc"), expect: []byte{0x0c, 0x61, 0x62, 0x63}},
+		{label: "Encode Bytes(64 bytes)", input: make(Bytes, 64), expect: append([]byte{0x01, 0x01}, make([]byte, 64)...)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+			assert.Equal(t, e.expect, BytesToSequenceU8(e.input).Bytes())
+		})
+	}
+}
+
+func Test_DecodeBytes(t *testing.T) {
+	input := make([]byte, 3000)
+	for i := range input {
+		input[i] = byte(i)
+	}
+	encoded := Bytes(input).Bytes()
+
+	var examples = []struct {
+		label  string
+		reader io.Reader
+	}{
+		{label: "bytes.Buffer", reader: bytes.NewBuffer(encoded)},
+		{label: "unknown size", reader: plainReader{bytes.NewReader(encoded)}},
+		{label: "LimitedReader", reader: NewLimitedReader(bytes.NewBuffer(encoded), DecodeOptions{MaxBytes: 3002})},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			var result Bytes
+			err := result.Decode(e.reader)
+
+			assert.NoError(t, err)
+			assert.Equal(t, Bytes(input), result)
+		})
+	}
+}
+
+func Test_DecodeBytes_Truncated(t *testing.T) {
+	// the length is 3000 in the two-byte mode
+	input := append([]byte{0xe1, 0x2e}, make([]byte, 2500)...)
+
+	_, err := DecodeBytes(plainReader{bytes.NewReader(input)})
+
+	var decodeErr *DecodeError
+	assert.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, &DecodeError{Type: "Bytes", Offset: -1, Read: 2500, Expected: 3000, Err: io.ErrUnexpectedEOF}, decodeErr)
+
+	// a forged length with little input
+	_, err = DecodeBytes(plainReader{bytes.NewReader([]byte{0x03, 0xff, 0xff, 0xff, 0xff, 0x01})})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = DecodeBytes(bytes.NewBuffer([]byte{0x08, 0x01}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = DecodeBytes(NewLimitedReader(bytes.NewBuffer([]byte{0x08, 0x01, 0x02}), DecodeOptions{MaxLength: 1}))
+	var limitErr *LimitError
+	assert.ErrorAs(t, err, &limitErr)
+}
+
+func Test_DecodeBytesAliased(t *testing.T) {
+	input := []byte{0x0c, 0x61, 0x62, 0x63, 0x04}
+	buffer := bytes.NewBuffer(input)
+
+	result, err := DecodeBytesAliased(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, Bytes("abc"), result)
+	assert.Equal(t, 1, buffer.Len())
+
+	// shares the memory of the input
+	result[0] = 0x7a
+	assert.Equal(t, byte(0x7a), input[1])
+
+	// copies from the other readers
+	input = []byte{0x0c, 0x61, 0x62, 0x63}
+	result, err = DecodeBytesAliased(bytes.NewReader(input))
+	assert.NoError(t, err)
+	result[0] = 0x7a
+	assert.Equal(t, byte(0x61), input[1])
+
+	_, err = DecodeBytesAliased(bytes.NewBuffer([]byte{0x0c, 0x61}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// the readers without Len return short slices from Next
+	_, err = DecodeBytesAliased(nextReader{bytes.NewBuffer([]byte{0x0c, 0x61})})
+	assert.Equal(t, &DecodeError{Type: "Bytes", Offset: -1, Read: 1, Expected: 3, Err: io.ErrUnexpectedEOF}, err)
+}
+
+type nextReader struct {
+	buffer *bytes.Buffer
+}
+
+func (r nextReader) Read(p []byte) (int, error) {
+	return r.buffer.Read(p)
+}
+
+func (r nextReader) Next(n int) []byte {
+	return r.buffer.Next(n)
+}
+
+func Benchmark_DecodeBytes(b *testing.B) {
+	encoded := make(Bytes, 1<<20).Bytes()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = DecodeBytes(bytes.NewReader(encoded))
+	}
+}
+
+func Benchmark_DecodeSequenceU8(b *testing.B) {
+	encoded := make(Bytes, 1<<20).Bytes()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = DecodeSequence[U8](bytes.NewReader(encoded))
+	}
+}
+
+type len3 struct{}
+
+func (len3) Length() int { return 3 }
+
+func Test_NewFixedSequenceOf(t *testing.T) {
+	result, err := NewFixedSequenceOf[U8, len3](5, 6, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedSequenceOf[U8, len3]{5, 6, 7}, result)
+
+	_, err = NewFixedSequenceOf[U8, len3](5, 6)
+	assert.Equal(t, errFixedSequenceLength, err)
+}
+
+func Test_EncodeFixedSequenceOf(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  FixedSequenceOf[U16, len3]
+		expect []byte
+		err    error
+	}{
+		{label: "Encode FixedSequenceOf[U16, len3]", input: FixedSequenceOf[U16, len3]{1, 2, 3}, expect: []byte{0x1, 0x0, 0x2, 0x0, 0x3, 0x0}},
+		{label: "Encode FixedSequenceOf[U16, len3] too short", input: FixedSequenceOf[U16, len3]{1, 2}, expect: []byte{}, err: errFixedSequenceLength},
+		{label: "Encode FixedSequenceOf[U16, len3] too long", input: FixedSequenceOf[U16, len3]{1, 2, 3, 4}, expect: []byte{}, err: errFixedSequenceLength},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.Equal(t, e.err, err)
+			assert.Equal(t, e.expect, append([]byte{}, buffer.Bytes()...))
+		})
+	}
+}
+
+func Test_DecodeFixedSequenceOf(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x1, 0x0, 0x2, 0x0, 0x3, 0x0, 0x4, 0x0})
+
+	result, err := DecodeFixedSequenceOf[U16, len3](buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, FixedSequenceOf[U16, len3]{1, 2, 3}, result)
+	assert.Equal(t, 2, buffer.Len())
+
+	// the length does not depend on the allocated one
+	var fseq FixedSequenceOf[U16, len3]
+	err = fseq.Decode(bytes.NewBuffer([]byte{0x1, 0x0, 0x2, 0x0, 0x3, 0x0}))
+	assert.NoError(t, err)
+	assert.Equal(t, FixedSequenceOf[U16, len3]{1, 2, 3}, fseq)
+
+	_, err = DecodeFixedSequenceOf[U16, len3](bytes.NewBuffer([]byte{0x1, 0x0, 0x2, 0x0}))
+	assert.Equal(t, &DecodeError{Type: "FixedSequence", Offset: -1, Err: io.ErrUnexpectedEOF}, err)
+
+	nested, err := DecodeSequence[FixedSequenceOf[U8, len3]](bytes.NewBuffer([]byte{0x08, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6}))
+	assert.NoError(t, err)
+	assert.Equal(t, Sequence[FixedSequenceOf[U8, len3]]{{1, 2, 3}, {4, 5, 6}}, nested)
+}
+
 func Test_DecodeStr_Empty(t *testing.T) {
 	buffer := &bytes.Buffer{}
 
@@ -0,0 +1,191 @@
+package goscale
+
+/*
+	Ref: https://doc.rust-lang.org/std/collections/struct.BTreeSet.html
+
+	SCALE encodes BTreeSet like a Sequence of its elements in ascending order.
+*/
+
+import (
+	"errors"
+	"io"
+	"reflect"
+)
+
+var (
+	errSetNotCanonical = errors.New("set elements are not sorted or not unique")
+)
+
+type Set[T Comparable] map[T]struct{}
+
+func NewSet[T Comparable](values ...T) Set[T] {
+	s := make(Set[T], len(values))
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+func (s Set[T]) Contains(value T) bool {
+	_, ok := s[value]
+	return ok
+}
+
+// Insert adds value, it is false if the value is already in the set.
+func (s Set[T]) Insert(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+	s[value] = struct{}{}
+	return true
+}
+
+// Remove deletes value, it is false if the value is not in the set.
+func (s Set[T]) Remove(value T) bool {
+	if !s.Contains(value) {
+		return false
+	}
+	delete(s, value)
+	return true
+}
+
+// Values returns the elements in ascending order.
+func (s Set[T]) Values() []T {
+	values := make([]T, 0, len(s))
+	for v := range s {
+		values = append(values, v)
+	}
+	sortKeys(values)
+	return values
+}
+
+// Union returns the elements of s or other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := make(Set[T], len(s)+len(other))
+	for v := range s {
+		result[v] = struct{}{}
+	}
+	for v := range other {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns the elements of both s and other.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	result := Set[T]{}
+	for v := range s {
+		if other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns the elements of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := Set[T]{}
+	for v := range s {
+		if !other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+func (s Set[T]) Encode(writer io.Writer) error {
+	err := checkKeyOrder[T]()
+	if err != nil {
+		return err
+	}
+
+	err = ToCompact(len(s)).Encode(writer)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range s.Values() {
+		err := v.Encode(writer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Set[T]) Bytes() []byte {
+	return EncodedBytes(s)
+}
+
+func (s Set[T]) EncodedLen() int {
+	size := compactEncodedLen(uint64(len(s)))
+	for v := range s {
+		size += EncodedLen(v)
+	}
+	return size
+}
+
+// allows reflection based codecs to tell Set[T] apart from Dictionary[K, V]
+func (s Set[T]) set() {}
+
+// DecodeSet merges the duplicate elements, unless the reader is a LimitedReader
+// with DecodeOptions.Strict set, which rejects the elements that are not in ascending order.
+func DecodeSet[T Comparable](reader io.Reader) (Set[T], error) {
+	return decodeSet[T](reader, isStrict(reader))
+}
+
+// DecodeSetStrict rejects the elements that are not in ascending order regardless of the reader options.
+func DecodeSetStrict[T Comparable](reader io.Reader) (Set[T], error) {
+	return decodeSet[T](reader, true)
+}
+
+func decodeSet[T Comparable](reader io.Reader, strict bool) (Set[T], error) {
+	size, err := DecodeLength(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = descend(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, size, reflect.TypeOf(*new(T)))
+	if err != nil {
+		return nil, err
+	}
+
+	if strict {
+		err = checkKeyOrder[T]()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(Set[T], preallocate(reader, size))
+	var previous T
+	for i := 0; i < size; i++ {
+		value, err := decodeInto[T](reader)
+		if err != nil {
+			return nil, Decoder{Reader: reader, Type: "Set"}.Truncated(err)
+		}
+		if strict && i > 0 && !lessKey(previous, value) {
+			return nil, errSetNotCanonical
+		}
+		result[value] = struct{}{}
+		previous = value
+	}
+
+	return result, nil
+}
+
+func (s *Set[T]) Decode(reader io.Reader) error {
+	result, err := DecodeSet[T](reader)
+	if err != nil {
+		return err
+	}
+	*s = result
+	return nil
+}
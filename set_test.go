@@ -0,0 +1,136 @@
+package goscale
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Set(t *testing.T) {
+	s := NewSet[U16](3, 1, 2, 1)
+
+	assert.Len(t, s, 3)
+	assert.True(t, s.Contains(1))
+	assert.False(t, s.Contains(4))
+	assert.Equal(t, []U16{1, 2, 3}, s.Values())
+
+	assert.True(t, s.Insert(4))
+	assert.False(t, s.Insert(4))
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+	assert.Equal(t, []U16{2, 3, 4}, s.Values())
+}
+
+func Test_Set_Algebra(t *testing.T) {
+	a := NewSet[Str]("a", "b", "c")
+	b := NewSet[Str]("b", "c", "d")
+
+	var testExamples = []struct {
+		label  string
+		result Set[Str]
+		expect Set[Str]
+	}{
+		{label: "Union", result: a.Union(b), expect: NewSet[Str]("a", "b", "c", "d")},
+		{label: "Intersection", result: a.Intersection(b), expect: NewSet[Str]("b", "c")},
+		{label: "Difference", result: a.Difference(b), expect: NewSet[Str]("a")},
+		{label: "Difference reversed", result: b.Difference(a), expect: NewSet[Str]("d")},
+		{label: "Union with empty", result: a.Union(Set[Str]{}), expect: a},
+		{label: "Intersection with empty", result: a.Intersection(nil), expect: Set[Str]{}},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expect, testExample.result)
+		})
+	}
+
+	// the operands are not modified
+	assert.Equal(t, NewSet[Str]("a", "b", "c"), a)
+	assert.Equal(t, NewSet[Str]("b", "c", "d"), b)
+}
+
+func Test_Set_Encode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  Set[I16]
+		expect []byte
+	}{
+		{label: "Encode Set[I16]{}", input: Set[I16]{}, expect: []byte{0x00}},
+		{label: "Encode Set[I16](nil)", input: nil, expect: []byte{0x00}},
+		{label: "Encode Set[I16]{2, -1, 1}", input: NewSet[I16](2, -1, 1), expect: []byte{0x0c, 0xff, 0xff, 0x01, 0x00, 0x02, 0x00}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := e.input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, e.input.Bytes())
+			assert.Equal(t, len(e.expect), e.input.EncodedLen())
+		})
+	}
+}
+
+func Test_Set_Decode(t *testing.T) {
+	var examples = []struct {
+		label        string
+		input        []byte
+		expect       Set[U8]
+		strictExpect error
+	}{
+		{label: "sorted", input: []byte{0x0c, 0x01, 0x02, 0x03}, expect: NewSet[U8](1, 2, 3)},
+		{label: "empty", input: []byte{0x00}, expect: Set[U8]{}},
+		{label: "unsorted", input: []byte{0x0c, 0x03, 0x01, 0x02}, expect: NewSet[U8](1, 2, 3), strictExpect: errSetNotCanonical},
+		{label: "duplicate", input: []byte{0x0c, 0x01, 0x01, 0x02}, expect: NewSet[U8](1, 2), strictExpect: errSetNotCanonical},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			var result Set[U8]
+			err := result.Decode(bytes.NewBuffer(e.input))
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+
+			_, err = DecodeSet[U8](NewLimitedReader(bytes.NewBuffer(e.input), DecodeOptions{Strict: true}))
+			assert.Equal(t, e.strictExpect, err)
+
+			_, err = DecodeSetStrict[U8](bytes.NewBuffer(e.input))
+			assert.Equal(t, e.strictExpect, err)
+		})
+	}
+
+	_, err := DecodeSet[U8](bytes.NewBuffer([]byte{0x0c, 0x01}))
+	assert.Error(t, err)
+}
+
+type TupleSet struct {
+	Tuple
+	A Set[Str]
+	B U8
+}
+
+func Test_TupleSet(t *testing.T) {
+	input := TupleSet{A: NewSet[Str]("b", "a"), B: 1}
+	expect := []byte{0x08, 0x04, 0x61, 0x04, 0x62, 0x01}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	size, err := TupleEncodedLen(input)
+	assert.NoError(t, err)
+	assert.Equal(t, len(expect), size)
+
+	result := TupleSet{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	err = DecodeTuple(&result, NewLimitedReader(bytes.NewBuffer([]byte{0x08, 0x04, 0x62, 0x04, 0x61, 0x01}), DecodeOptions{Strict: true}))
+	assert.ErrorIs(t, err, errSetNotCanonical)
+}
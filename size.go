@@ -0,0 +1,211 @@
+package goscale
+
+/*
+	Ref: https://docs.rs/parity-scale-codec/latest/parity_scale_codec/trait.MaxEncodedLen.html
+
+	The length of the encoding of a value is computed without encoding it.
+	MaxEncodedLen is provided by the types whose encoding is bounded regardless of the value.
+*/
+
+import (
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+)
+
+// Sizer is implemented by all built-in types.
+type Sizer interface {
+	EncodedLen() int
+}
+
+// MaxSizer is implemented by the built-in types of bounded length.
+type MaxSizer interface {
+	MaxEncodedLen() int
+}
+
+// maxSizer is implemented by the generic types that are bounded if their type parameters are,
+// e.g. Option[T].
+type maxSizer interface {
+	maxEncodedLen() (int, bool)
+}
+
+// EncodedLen returns the length of the encoding of value. Tuples are measured
+// with TupleEncodedLen and the other types that do not implement Sizer are encoded.
+func EncodedLen(value Encodable) int {
+	if sizer, ok := value.(Sizer); ok {
+		return sizer.EncodedLen()
+	}
+	if isTuple(reflect.TypeOf(value)) {
+		// the fields that can not be encoded are not counted, EncodeTuple fails on them
+		size, _ := TupleEncodedLen(value)
+		return size
+	}
+	return len(value.Bytes())
+}
+
+// MaxEncodedLen returns the maximum length of the encoding of the values of type T,
+// it is false if the length is not bounded.
+func MaxEncodedLen[T Encodable]() (int, bool) {
+	return maxEncodedLenOf(reflect.TypeOf(*new(T)))
+}
+
+// isZeroSized reports whether the values of type t are encoded in no bytes, e.g. Empty.
+func isZeroSized(t reflect.Type) bool {
+	n, ok := maxEncodedLenOf(t)
+	return ok && n == 0
+}
+
+func maxEncodedLenOf(t reflect.Type) (int, bool) {
+	if t == nil {
+		// interfaces like Encodable
+		return 0, false
+	}
+
+	switch value := reflect.Zero(t).Interface().(type) {
+	case MaxSizer:
+		return value.MaxEncodedLen(), true
+	case maxSizer:
+		return value.maxEncodedLen()
+	}
+
+	if t == reflect.TypeOf(*new(Tuple)) {
+		return 0, true
+	}
+	if !isTuple(t) {
+		return 0, false
+	}
+
+	size := 0
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		n, ok := maxEncodedLenOf(t.Field(i).Type)
+		if !ok {
+			return 0, false
+		}
+		size += n
+	}
+	return size, true
+}
+
+// TupleEncodedLen returns the length of the encoding of t by EncodeTuple.
+func TupleEncodedLen(t interface{}) (int, error) {
+	tVal := reflect.ValueOf(t)
+
+	if tVal.Kind() != reflect.Struct {
+		return 0, errNotTuple
+	}
+
+	return tupleFieldsEncodedLen(tVal, tVal.Type().Name())
+}
+
+func tupleFieldsEncodedLen(tVal reflect.Value, path string) (int, error) {
+	tType := tVal.Type()
+
+	size := 0
+	for i := 0; i < tVal.NumField(); i++ {
+		if !tType.Field(i).IsExported() {
+			continue
+		}
+
+		n, err := tupleFieldEncodedLen(tVal.Field(i), path+"."+tType.Field(i).Name)
+		size += n
+		if err != nil {
+			return size, err
+		}
+	}
+
+	return size, nil
+}
+
+// tupleFieldEncodedLen mirrors encodeTupleField.
+func tupleFieldEncodedLen(field reflect.Value, path string) (int, error) {
+	switch field.Kind() {
+	case reflect.Interface:
+		// the embedded Encodable of Tuple is not encoded
+		return 0, nil
+	case reflect.Struct:
+		if field.Type() == reflect.TypeOf(*new(Tuple)) {
+			return 0, nil
+		}
+	}
+
+	if sizer, ok := field.Interface().(Sizer); ok {
+		return sizer.EncodedLen(), nil
+	}
+
+	switch field.Kind() {
+	case reflect.Bool, reflect.String, reflect.Array,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// the built-in types are Sizers, the other named types encode themselves
+		if encodable, ok := field.Interface().(Encodable); ok {
+			return len(encodable.Bytes()), nil
+		}
+		return 0, newTupleFieldError(path, errTupleFieldNotSupported)
+	case reflect.Slice:
+		size := 0
+		if _, ok := field.Interface().(fixedSequence); !ok {
+			size = compactEncodedLen(uint64(field.Len()))
+		}
+		for i := 0; i < field.Len(); i++ {
+			n, err := tupleFieldEncodedLen(field.Index(i), path+"["+strconv.Itoa(i)+"]")
+			size += n
+			if err != nil {
+				return size, err
+			}
+		}
+		return size, nil
+	case reflect.Map:
+		switch field.Type().Key().Kind() {
+		case reflect.String,
+			reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return 0, newTupleFieldError(path, errTupleFieldNotSupported)
+		}
+
+		size := compactEncodedLen(uint64(field.Len()))
+		iter := field.MapRange()
+		for iter.Next() {
+			for _, v := range []reflect.Value{iter.Key(), iter.Value()} {
+				n, err := tupleFieldEncodedLen(v, path)
+				size += n
+				if err != nil {
+					return size, err
+				}
+			}
+		}
+		return size, nil
+	case reflect.Struct:
+		// Tuple
+		return tupleFieldsEncodedLen(field, path)
+	case reflect.Int, reflect.Uint, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return 0, newTupleFieldError(path, errTupleFieldNotSupported)
+	default:
+		return 0, newTupleFieldError(path, errTupleFieldNotImplemented)
+	}
+}
+
+// compactEncodedLen returns the length of the compact encoding of value.
+func compactEncodedLen(value uint64) int {
+	switch {
+	case value < 1<<6:
+		return 1
+	case value < 1<<14:
+		return 2
+	case value < 1<<30:
+		return 4
+	default:
+		return 1 + (bits.Len64(value)+7)/8
+	}
+}
+
+func compactBigIntEncodedLen(value *big.Int) int {
+	if value.IsUint64() {
+		return compactEncodedLen(value.Uint64())
+	}
+	return 1 + (value.BitLen()+7)/8
+}
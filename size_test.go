@@ -0,0 +1,188 @@
+package goscale
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodedLen(t *testing.T) {
+	bigValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	var examples = []struct {
+		label string
+		input Encodable
+	}{
+		{label: "Bool", input: Bool(true)},
+		{label: "U8", input: U8(1)},
+		{label: "I16", input: I16(-1)},
+		{label: "U32", input: U32(1)},
+		{label: "I64", input: I64(-1)},
+		{label: "U128", input: NewU128(1)},
+		{label: "I256", input: NewI256(-1)},
+		{label: "Compact(0)", input: ToCompact(0)},
+		{label: "Compact(63)", input: ToCompact(63)},
+		{label: "Compact(64)", input: ToCompact(64)},
+		{label: "Compact(1<<14)", input: ToCompact(1 << 14)},
+		{label: "Compact(1<<30)", input: ToCompact(1 << 30)},
+		{label: "Compact(MaxU64)", input: ToCompact(uint64(1<<64 - 1))},
+		{label: "Compact(big)", input: ToCompact(bigIntToU128(bigValue))},
+		{label: "CompactOf[U16]", input: NewCompactOf(U16(1 << 14))},
+		{label: "CompactOf[U128]", input: NewCompactOf(bigIntToU128(bigValue))},
+		{label: "Perbill", input: PerbillFromPercent(10)},
+		{label: "FixedI64", input: FixedI64(1)},
+		{label: "Bytes32", input: Bytes32{}},
+		{label: "Sequence[U8]", input: Sequence[U8]{1, 2, 3}},
+		{label: "Sequence[Str]", input: Sequence[Str]{"a", "bc"}},
+		{label: "Sequence[CompactOf[U32]]", input: Sequence[CompactOf[U32]]{NewCompactOf(U32(1)), NewCompactOf(U32(1 << 20))}},
+		{label: "FixedSequence[U16]", input: FixedSequence[U16]{1, 2}},
+		{label: "FixedSequenceOf[U8, len3]", input: FixedSequenceOf[U8, len3]{1, 2, 3}},
+		{label: "Bytes", input: make(Bytes, 100)},
+		{label: "Str", input: Str("abc")},
+		{label: "Dictionary[Str, Sequence[U8]]", input: Dictionary[Str, Sequence[U8]]{"a": {1}, "bc": {}}},
+		{label: "Option[U32](None)", input: Option[U32]{}},
+		{label: "Option[Str]", input: NewOption[Str](Str("abc"))},
+		{label: "OptionBool", input: OptionBool{true, false}},
+		{label: "Result[Encodable, Encodable]", input: Err[Encodable, Encodable](Sequence[U8]{42})},
+		{label: "VaryingData", input: NewVaryingData(U8(1), Str("abc"), U64(2))},
+		{label: "Empty", input: Empty{}},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			assert.Implements(t, (*Sizer)(nil), e.input)
+			assert.Equal(t, len(e.input.Bytes()), EncodedLen(e.input))
+		})
+	}
+}
+
+func Test_EncodedLen_Tuple(t *testing.T) {
+	input := TupleSequenceNested{
+		R0: Sequence[Option[U8]]{{true, 3}, {false, 0}},
+		R1: Sequence[TupleU8I8]{{B0: 1, B1: -1}},
+		R2: FixedSequence[TupleU8I8]{{B0: 2, B1: -2}},
+		R3: Dictionary[U8, Option[Bool]]{2: {false, false}, 1: {true, true}},
+	}
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+
+	size, err := TupleEncodedLen(input)
+	assert.NoError(t, err)
+	assert.Equal(t, buffer.Len(), size)
+	assert.Equal(t, buffer.Len(), EncodedLen(input))
+
+	all := TupleAll{
+		P0: TupleBool{A0: true, A1: false},
+		P1: TupleU8I8{B0: 1, B1: 2},
+		P3: TupleStr{H0: Str("abc"), H1: Str("xyz")},
+		P4: FixedSequence[TupleFixedSequence]{
+			TupleFixedSequence{J0: FixedSequence[Bool]{true, false, true}},
+		},
+	}
+	size, err = TupleEncodedLen(all)
+	assert.NoError(t, err)
+	assert.Equal(t, 15, size)
+
+	named := struct {
+		Tuple
+		A Percent
+		B Sequence[FixedI64]
+		C map[U8]Str
+	}{A: 1, B: Sequence[FixedI64]{1, 2}, C: map[U8]Str{1: "a"}}
+	buffer = &bytes.Buffer{}
+	err = EncodeTuple(named, buffer)
+	assert.NoError(t, err)
+	size, err = TupleEncodedLen(named)
+	assert.NoError(t, err)
+	assert.Equal(t, buffer.Len(), size)
+}
+
+func Test_EncodedLen_Tuple_Errors(t *testing.T) {
+	_, err := TupleEncodedLen(U8(1))
+	assert.Equal(t, errNotTuple, err)
+
+	_, err = TupleEncodedLen(struct{ A int }{})
+	assert.ErrorIs(t, err, errTupleFieldNotSupported)
+
+	// like EncodeTuple
+	_, err = TupleEncodedLen(struct{ A []uint16 }{A: []uint16{1}})
+	assert.ErrorIs(t, err, errTupleFieldNotSupported)
+	assert.ErrorIs(t, EncodeTuple(struct{ A []uint16 }{A: []uint16{1}}, &bytes.Buffer{}), errTupleFieldNotSupported)
+
+	_, err = TupleEncodedLen(struct{ A map[bool]U8 }{})
+	assert.ErrorIs(t, err, errTupleFieldNotSupported)
+
+	_, err = TupleEncodedLen(struct{ A chan U8 }{})
+	assert.ErrorIs(t, err, errTupleFieldNotImplemented)
+}
+
+type TupleBounded struct {
+	Tuple
+	A U32
+	B Option[CompactOf[U64]]
+	C FixedSequenceOf[Perbill, len3]
+	D Bytes32
+}
+
+type TupleUnbounded struct {
+	Tuple
+	A U32
+	B Sequence[U8]
+}
+
+func Test_MaxEncodedLen(t *testing.T) {
+	var examples = []struct {
+		label   string
+		max     func() (int, bool)
+		expect  int
+		bounded bool
+	}{
+		{label: "Bool", max: MaxEncodedLen[Bool], expect: 1, bounded: true},
+		{label: "I64", max: MaxEncodedLen[I64], expect: 8, bounded: true},
+		{label: "U256", max: MaxEncodedLen[U256], expect: 32, bounded: true},
+		{label: "CompactOf[U8]", max: MaxEncodedLen[CompactOf[U8]], expect: 2, bounded: true},
+		{label: "CompactOf[U16]", max: MaxEncodedLen[CompactOf[U16]], expect: 4, bounded: true},
+		{label: "CompactOf[U32]", max: MaxEncodedLen[CompactOf[U32]], expect: 5, bounded: true},
+		{label: "CompactOf[U64]", max: MaxEncodedLen[CompactOf[U64]], expect: 9, bounded: true},
+		{label: "CompactOf[U128]", max: MaxEncodedLen[CompactOf[U128]], expect: 17, bounded: true},
+		{label: "Perquintill", max: MaxEncodedLen[Perquintill], expect: 8, bounded: true},
+		{label: "FixedU128", max: MaxEncodedLen[FixedU128], expect: 16, bounded: true},
+		{label: "Bytes65", max: MaxEncodedLen[Bytes65], expect: 65, bounded: true},
+		{label: "Empty", max: MaxEncodedLen[Empty], expect: 0, bounded: true},
+		{label: "OptionBool", max: MaxEncodedLen[OptionBool], expect: 1, bounded: true},
+		{label: "Option[U128]", max: MaxEncodedLen[Option[U128]], expect: 17, bounded: true},
+		{label: "Result[U8, U32]", max: MaxEncodedLen[Result[U8, U32]], expect: 5, bounded: true},
+		{label: "FixedSequenceOf[U16, len3]", max: MaxEncodedLen[FixedSequenceOf[U16, len3]], expect: 6, bounded: true},
+		{label: "Tuple", max: MaxEncodedLen[TupleBounded], expect: 4 + 10 + 12 + 32, bounded: true},
+		{label: "Option[Tuple]", max: MaxEncodedLen[Option[TupleU8I8]], expect: 3, bounded: true},
+		{label: "Compact", max: MaxEncodedLen[Compact]},
+		{label: "Sequence[U8]", max: MaxEncodedLen[Sequence[U8]]},
+		{label: "FixedSequence[U8]", max: MaxEncodedLen[FixedSequence[U8]]},
+		{label: "Str", max: MaxEncodedLen[Str]},
+		{label: "Option[Str]", max: MaxEncodedLen[Option[Str]]},
+		{label: "Result[Encodable, Encodable]", max: MaxEncodedLen[Result[Encodable, Encodable]]},
+		{label: "Unbounded Tuple", max: MaxEncodedLen[TupleUnbounded]},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, ok := e.max()
+
+			assert.Equal(t, e.bounded, ok)
+			if e.bounded {
+				assert.Equal(t, e.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MaxEncodedLen_IsMax(t *testing.T) {
+	max, _ := MaxEncodedLen[CompactOf[U128]]()
+	assert.Equal(t, max, NewCompactOf(MaxU128()).EncodedLen())
+
+	max, _ = MaxEncodedLen[CompactOf[U32]]()
+	assert.Equal(t, max, len(NewCompactOf(U32(1<<32-1)).Bytes()))
+}
@@ -1,10 +1,57 @@
 package goscale
 
 import (
-	"bytes"
+	"errors"
+	"io"
 	"reflect"
+	"sort"
+	"strconv"
 )
 
+var (
+	errNotTuple                 = errors.New("not a SCALE Tuple type")
+	errNotTuplePointer          = errors.New("not a pointer to a SCALE Tuple type")
+	errTupleFieldNotSupported   = errors.New("T field is not supported")
+	errTupleFieldNotImplemented = errors.New("T field is not implemented")
+)
+
+type optional interface {
+	option() bool
+}
+
+type fixedSequence interface {
+	fixedSequence()
+}
+
+type fixedLength interface {
+	fixedLength() int
+}
+
+type set interface {
+	Encodable
+	set()
+}
+
+type compactOf interface {
+	Encodable
+	compactOf()
+}
+
+type enum interface {
+	Encodable
+	enum()
+}
+
+type result interface {
+	Encodable
+	decodeResult(reader io.Reader) (Encodable, error)
+}
+
+type keyOrdering interface {
+	lessKeys(a, b any) bool
+	keysOrdered() bool
+}
+
 /*
 	https://spec.polkadot.network/#defn-scale-tuple
 
@@ -15,7 +62,7 @@ type Tuple struct {
 	Encodable
 }
 
-func (t Tuple) Encode(buffer *bytes.Buffer) error {
+func (t Tuple) Encode(writer io.Writer) error {
 	panic("allows the Tuple type to conform to the Encodable interface")
 }
 
@@ -23,199 +70,300 @@ func (t Tuple) Bytes() []byte {
 	panic("allows the Tuple type to conform to the Encodable interface")
 }
 
-func EncodeTuple(t interface{}, buffer *bytes.Buffer) {
+// isTuple reports whether t is a struct type embedding the Tuple type.
+func isTuple(t reflect.Type) bool {
+	if t == nil || t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type == reflect.TypeOf(*new(Tuple)) {
+			return true
+		}
+	}
+	return false
+}
+
+// TupleFieldError reports the path of the struct field that could not be encoded or decoded,
+// e.g. "TupleAll.P4[1].J0".
+type TupleFieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *TupleFieldError) Error() string {
+	return "tuple field " + e.Path + ": " + e.Err.Error()
+}
+
+func (e *TupleFieldError) Unwrap() error {
+	return e.Err
+}
+
+func newTupleFieldError(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var fieldErr *TupleFieldError
+	if errors.As(err, &fieldErr) {
+		return err
+	}
+
+	return &TupleFieldError{Path: path, Err: err}
+}
+
+// EncodeTuple encodes the exported fields of the struct t in declaration order.
+// A field that can not be encoded is reported as a *TupleFieldError.
+func EncodeTuple(t interface{}, writer io.Writer) error {
 	tVal := reflect.ValueOf(t)
 
 	if tVal.Kind() != reflect.Struct {
-		panic("not a SCALE Tuple type")
+		return errNotTuple
 	}
 
+	return encodeTupleFields(tVal, tVal.Type().Name(), writer)
+}
+
+func encodeTupleFields(tVal reflect.Value, path string, writer io.Writer) error {
+	tType := tVal.Type()
+
 	// Tinygo does not support: reflect.VisibleFields(tVal.Type())
 	for i := 0; i < tVal.NumField(); i++ {
-		field := tVal.Field(i)
-
-		if reflect.TypeOf(t).Field(i).IsExported() {
-
-			switch field.Kind() {
-			case reflect.Bool:
-				ConvertTo[Bool](field).Encode(buffer)
-			case reflect.Uint8:
-				ConvertTo[U8](field).Encode(buffer)
-			case reflect.Int8:
-				ConvertTo[I8](field).Encode(buffer)
-			case reflect.Uint16:
-				ConvertTo[U16](field).Encode(buffer)
-			case reflect.Int16:
-				ConvertTo[I16](field).Encode(buffer)
-			case reflect.Uint32:
-				ConvertTo[U32](field).Encode(buffer)
-			case reflect.Int32:
-				ConvertTo[I32](field).Encode(buffer)
-			case reflect.Uint64:
-				ConvertTo[U64](field).Encode(buffer)
-			case reflect.Int64:
-				ConvertTo[I64](field).Encode(buffer)
-			case reflect.String:
-				ConvertTo[Str](field).Encode(buffer)
-			case reflect.Array:
-				// U128, I128, Compact
-				switch field.Type() {
-				case reflect.TypeOf(*new(U128)):
-					ConvertTo[U128](field).Encode(buffer)
-				case reflect.TypeOf(*new(I128)):
-					ConvertTo[I128](field).Encode(buffer)
-				default:
-					panic("unreachable case (Array) in EncodeTuple")
-				}
-			case reflect.Slice:
-				// Sequence[T], FixedSequence[T], VaryingData
-				SequenceFieldEncode(field, buffer)
-			case reflect.Map:
-				DictionaryFieldEncode(field, buffer)
-			case reflect.Struct:
-				switch field.Type() {
-				case reflect.TypeOf(*new(Empty)):
-					EncodeTuple(field.Interface(), buffer)
-				case reflect.TypeOf(*new(Compact)):
-					ConvertTo[Compact](field).Encode(buffer)
-				default:
-					// Option[T], Result[T], Tuple
-					if field.Kind() == reflect.Struct {
-						EncodeTuple(field.Interface(), buffer)
-					} else {
-						panic("unreachable case (Struct) in EncodeTuple")
-					}
-				}
-			case reflect.Int, reflect.Uint, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
-				panic("encoding of T field is not supported")
-			case reflect.Uintptr, reflect.UnsafePointer, reflect.Pointer, reflect.Chan, reflect.Func:
-				panic("encoding of T field is not implemented")
-			case reflect.Interface:
-				/*
-					Here it does nothing, but that allows the usage of the embedded Encodable
-					in custom-defined structs which allows using them in places where Encodable
-					is expected like in the case of Option[T], Result[T].
-				*/
-			default:
-				panic("unreachable case in EncodeTuple")
-			}
+		if !tType.Field(i).IsExported() {
+			continue
+		}
+
+		err := encodeTupleField(tVal.Field(i), path+"."+tType.Field(i).Name, writer)
+		if err != nil {
+			return err
+		}
+	}
 
+	return nil
+}
+
+func encodeTupleField(field reflect.Value, path string, writer io.Writer) error {
+	switch field.Kind() {
+	case reflect.Bool:
+		return encodeAs[Bool](field, path, writer)
+	case reflect.Uint8:
+		return encodeAs[U8](field, path, writer)
+	case reflect.Int8:
+		return encodeAs[I8](field, path, writer)
+	case reflect.Uint16:
+		return encodeAs[U16](field, path, writer)
+	case reflect.Int16:
+		return encodeAs[I16](field, path, writer)
+	case reflect.Uint32:
+		return encodeAs[U32](field, path, writer)
+	case reflect.Int32:
+		return encodeAs[I32](field, path, writer)
+	case reflect.Uint64:
+		return encodeAs[U64](field, path, writer)
+	case reflect.Int64:
+		return encodeAs[I64](field, path, writer)
+	case reflect.String:
+		return encodeAs[Str](field, path, writer)
+	case reflect.Array:
+		// U128, I128, U256, I256
+		switch field.Type() {
+		case reflect.TypeOf(*new(U128)):
+			return encodeAs[U128](field, path, writer)
+		case reflect.TypeOf(*new(I128)):
+			return encodeAs[I128](field, path, writer)
+		case reflect.TypeOf(*new(U256)):
+			return encodeAs[U256](field, path, writer)
+		case reflect.TypeOf(*new(I256)):
+			return encodeAs[I256](field, path, writer)
+		default:
+			// FixedU128, FixedI128, Bytes32 etc.
+			if e, ok := field.Interface().(Encodable); ok {
+				return newTupleFieldError(path, e.Encode(writer))
+			}
+			return newTupleFieldError(path, errTupleFieldNotSupported)
+		}
+	case reflect.Slice:
+		// Sequence[T], FixedSequence[T], VaryingData
+		return sequenceFieldEncode(field, path, writer)
+	case reflect.Map:
+		// Set[T]
+		if s, ok := field.Interface().(set); ok {
+			return newTupleFieldError(path, s.Encode(writer))
 		}
+		return dictionaryFieldEncode(field, path, writer)
+	case reflect.Struct:
+		switch field.Type() {
+		case reflect.TypeOf(*new(Empty)), reflect.TypeOf(*new(Tuple)):
+			return nil
+		case reflect.TypeOf(*new(Compact)):
+			return encodeAs[Compact](field, path, writer)
+		default:
+			// CompactOf[T]
+			if c, ok := field.Interface().(compactOf); ok {
+				return newTupleFieldError(path, c.Encode(writer))
+			}
+			// Enum[D]
+			if e, ok := field.Interface().(enum); ok {
+				return newTupleFieldError(path, e.Encode(writer))
+			}
+			// Result[T, E]
+			if r, ok := field.Interface().(result); ok {
+				return newTupleFieldError(path, r.Encode(writer))
+			}
+			// Option[T] without a value is encoded as a single byte
+			if o, ok := field.Interface().(optional); ok && !o.option() {
+				return newTupleFieldError(path, Bool(false).Encode(writer))
+			}
+			// Option[T], Tuple
+			return encodeTupleFields(field, path, writer)
+		}
+	case reflect.Interface:
+		/*
+			Here it does nothing, but that allows the usage of the embedded Encodable
+			in custom-defined structs which allows using them in places where Encodable
+			is expected like in the case of Option[T], Result[T, E].
+		*/
+		return nil
+	case reflect.Int, reflect.Uint, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return newTupleFieldError(path, errTupleFieldNotSupported)
+	default:
+		// reflect.Uintptr, reflect.UnsafePointer, reflect.Pointer, reflect.Chan, reflect.Func
+		return newTupleFieldError(path, errTupleFieldNotImplemented)
 	}
 }
 
-func SequenceFieldEncode(field reflect.Value, buffer *bytes.Buffer) {
+func SequenceFieldEncode(field reflect.Value, writer io.Writer) error {
+	return sequenceFieldEncode(field, field.Type().Name(), writer)
+}
+
+func sequenceFieldEncode(field reflect.Value, path string, writer io.Writer) error {
+	if field.Type() == reflect.TypeOf(*new(VaryingData)) {
+		return encodeAs[VaryingData](field, path, writer)
+	}
+
 	// Tinygo does not support: reflect.SliceOf(field.Type())
 	switch field.Type().Elem() {
-	case reflect.TypeOf(*new(Bool)):
-		ConvertToSequence[Bool](field).Encode(buffer)
-	case reflect.TypeOf(*new(U8)):
-		ConvertToSequence[U8](field).Encode(buffer)
-	case reflect.TypeOf(*new(I8)):
-		ConvertToSequence[I8](field).Encode(buffer)
-	case reflect.TypeOf(*new(U16)):
-		ConvertToSequence[U16](field).Encode(buffer)
-	case reflect.TypeOf(*new(I16)):
-		ConvertToSequence[I16](field).Encode(buffer)
-	case reflect.TypeOf(*new(U32)):
-		ConvertToSequence[U32](field).Encode(buffer)
-	case reflect.TypeOf(*new(I32)):
-		ConvertToSequence[I32](field).Encode(buffer)
-	case reflect.TypeOf(*new(U64)):
-		ConvertToSequence[U64](field).Encode(buffer)
-	case reflect.TypeOf(*new(I64)):
-		ConvertToSequence[I64](field).Encode(buffer)
-	case reflect.TypeOf(*new(U128)):
-		ConvertToSequence[U128](field).Encode(buffer)
-	case reflect.TypeOf(*new(I128)):
-		ConvertToSequence[I128](field).Encode(buffer)
-	case reflect.TypeOf(*new(Str)):
-		ConvertToSequence[Str](field).Encode(buffer)
-	case reflect.TypeOf(*new(VaryingData)):
-		ConvertToSequence[VaryingData](field).Encode(buffer)
-
-	// TODO: Sequence[Sequence[T]]
-	// TODO: Sequence[FixedSequence[T]]
-	// TODO: Sequence[Dictionary[T1, T2]]
-	case reflect.TypeOf(*new(Sequence[Bool])):
-		size := field.Len()
-		ToCompact(size).Encode(buffer)
-		for i := 0; i < field.Len(); i++ {
-			SequenceFieldEncode(field.Index(i), buffer)
+	case reflect.TypeOf(*new(Bool)),
+		reflect.TypeOf(*new(U8)),
+		reflect.TypeOf(*new(byte)),
+		reflect.TypeOf(*new(I8)),
+		reflect.TypeOf(*new(U16)),
+		reflect.TypeOf(*new(I16)),
+		reflect.TypeOf(*new(U32)),
+		reflect.TypeOf(*new(I32)),
+		reflect.TypeOf(*new(U64)),
+		reflect.TypeOf(*new(I64)),
+		reflect.TypeOf(*new(U128)),
+		reflect.TypeOf(*new(I128)),
+		reflect.TypeOf(*new(Str)),
+		reflect.TypeOf(*new(VaryingData)):
+		// Sequence[T], FixedSequence[T] and Bytes of types that encode themselves
+		if value, ok := field.Interface().(Encodable); ok {
+			return newTupleFieldError(path, value.Encode(writer))
 		}
+	}
 
-	default:
+	// Sequence[Sequence[T]], Sequence[Option], Sequence[Result], Sequence[Tuple]
+	size := field.Len()
+	if l, ok := field.Interface().(fixedLength); ok && l.fixedLength() != size {
+		return newTupleFieldError(path, errFixedSequenceLength)
+	}
+	err := checkBound(field, size)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	if _, ok := field.Interface().(fixedSequence); !ok {
+		err := ToCompact(size).Encode(writer)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+	}
 
-		switch field.Type() {
-		case reflect.TypeOf(*new(VaryingData)):
-			ConvertTo[VaryingData](field).Encode(buffer)
-		default:
-			// Sequence[Option], Sequence[Result], Sequence[Tuple]
-
-			// since there are infinite number of T we can't use switch
-			size := field.Len()
-			// TODO: if it is a FixedSequence[T] don't encode the length
-			ToCompact(size).Encode(buffer)
-			for i := 0; i < size; i++ {
-				seqElem := field.Index(i)
-				EncodeTuple(seqElem.Interface(), buffer)
-			}
+	for i := 0; i < size; i++ {
+		err := encodeTupleField(field.Index(i), path+"["+strconv.Itoa(i)+"]", writer)
+		if err != nil {
+			return err
 		}
 	}
+
+	return nil
+}
+
+func DictionaryFieldEncode(field reflect.Value, writer io.Writer) error {
+	return dictionaryFieldEncode(field, field.Type().Name(), writer)
 }
 
-func DictionaryFieldEncode(field reflect.Value, buffer *bytes.Buffer) {
+func dictionaryFieldEncode(field reflect.Value, path string, writer io.Writer) error {
+	err := checkBound(field, field.Len())
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
 	// Tinygo does not support: reflect.MapOf(key.Type(), elem.Type())
-	switch field.Type().Elem() {
-	case reflect.TypeOf(*new(Bool)):
-		ConvertToDictionary[Str, Bool](field).Encode(buffer)
-	case reflect.TypeOf(*new(U8)):
-		ConvertToDictionary[Str, U8](field).Encode(buffer)
-	case reflect.TypeOf(*new(I8)):
-		ConvertToDictionary[Str, I8](field).Encode(buffer)
-	case reflect.TypeOf(*new(U16)):
-		ConvertToDictionary[Str, U16](field).Encode(buffer)
-	case reflect.TypeOf(*new(I16)):
-		ConvertToDictionary[Str, I16](field).Encode(buffer)
-	case reflect.TypeOf(*new(U32)):
-		ConvertToDictionary[Str, U32](field).Encode(buffer)
-	case reflect.TypeOf(*new(I32)):
-		ConvertToDictionary[Str, I32](field).Encode(buffer)
-	case reflect.TypeOf(*new(U64)):
-		ConvertToDictionary[Str, U64](field).Encode(buffer)
-	case reflect.TypeOf(*new(I64)):
-		ConvertToDictionary[Str, I64](field).Encode(buffer)
-	case reflect.TypeOf(*new(U128)):
-		ConvertToDictionary[Str, U128](field).Encode(buffer)
-	case reflect.TypeOf(*new(I128)):
-		ConvertToDictionary[Str, I128](field).Encode(buffer)
-	case reflect.TypeOf(*new(Compact)):
-		ConvertToDictionary[Str, Compact](field).Encode(buffer)
-	case reflect.TypeOf(*new(Str)):
-		ConvertToDictionary[Str, Str](field).Encode(buffer)
-	case reflect.TypeOf(*new(VaryingData)):
-		ConvertToDictionary[Str, VaryingData](field).Encode(buffer)
-
-	// TODO: Dictionary[Str, Sequence[T]]
-	// TODO: Dictionary[Str, FixedSequence[T]]
-	// TODO: Dictionary[Str, Dictionary]
+	keys := field.MapKeys()
+
+	less := mapKeyLess(field)
+	if less == nil {
+		return newTupleFieldError(path, errTupleFieldNotSupported)
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	err = ToCompact(len(keys)).Encode(writer)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	for i, key := range keys {
+		entryPath := path + "[" + strconv.Itoa(i) + "]"
+
+		err := encodeTupleField(key, entryPath+".key", writer)
+		if err != nil {
+			return err
+		}
+
+		err = encodeTupleField(field.MapIndex(key), entryPath+".value", writer)
+		if err != nil {
+			return err
+		}
+	}
 
+	return nil
+}
+
+// mapKeyLess returns the order of the keys of the map field, it is nil if the keys can not be ordered.
+func mapKeyLess(field reflect.Value) func(a, b reflect.Value) bool {
+	if ordering, ok := field.Interface().(keyOrdering); ok {
+		// Dictionary[K, V] keys
+		if !ordering.keysOrdered() {
+			return nil
+		}
+		return func(a, b reflect.Value) bool { return ordering.lessKeys(a.Interface(), b.Interface()) }
+	}
+
+	switch field.Type().Key().Kind() {
+	case reflect.String:
+		return func(a, b reflect.Value) bool { return a.String() < b.String() }
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
 	default:
-		switch field.Type() {
-		case reflect.TypeOf(*new(VaryingData)):
-			ConvertTo[VaryingData](field).Encode(buffer)
-		default:
-			// Dictionary[Str, Option], Dictionary[Str, Result], Dictionary[Str, Tuple]
-
-			// TODO: if it is a FixedSequence[T] don't encode the length
-			size := field.Len()
-			ToCompact(size).Encode(buffer)
-			for i := 0; i < size; i++ {
-				seqElem := field.Index(i)
-				EncodeTuple(seqElem.Interface(), buffer)
-			}
+		return nil
+	}
+}
+
+func encodeAs[T Encodable](field reflect.Value, path string, writer io.Writer) error {
+	value, ok := field.Interface().(T)
+	if !ok {
+		// named types of the same kind, e.g. Perbill, encode themselves
+		encodable, ok := field.Interface().(Encodable)
+		if !ok {
+			return newTupleFieldError(path, errTupleFieldNotSupported)
 		}
+		return newTupleFieldError(path, encodable.Encode(writer))
 	}
+	return newTupleFieldError(path, value.Encode(writer))
 }
 
 func ConvertToSequence[T Encodable](v reflect.Value) (value Encodable) {
@@ -245,3 +393,351 @@ func ConvertTo[T Encodable](v reflect.Value) Encodable {
 	}
 	return value
 }
+
+// DecodeTuple decodes into the exported fields of the struct pointed to by target,
+// walking them in the same order and with the same type mapping as EncodeTuple.
+// FixedSequence[T] fields must be allocated with their expected size beforehand,
+// FixedSequenceOf[T, L] fields are allocated with the length of L.
+func DecodeTuple(target interface{}, reader io.Reader) error {
+	tVal := reflect.ValueOf(target)
+
+	if tVal.Kind() != reflect.Pointer || tVal.IsNil() || tVal.Elem().Kind() != reflect.Struct {
+		return errNotTuplePointer
+	}
+
+	return decodeTupleFields(tVal.Elem(), tVal.Elem().Type().Name(), reader)
+}
+
+func decodeTupleFields(tVal reflect.Value, path string, reader io.Reader) error {
+	err := descend(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	defer ascend(reader)
+
+	tType := tVal.Type()
+
+	// Tinygo does not support: reflect.VisibleFields(tVal.Type())
+	consumed := false
+	for i := 0; i < tVal.NumField(); i++ {
+		if !tType.Field(i).IsExported() {
+			continue
+		}
+
+		err := decodeTupleField(tVal.Field(i), path+"."+tType.Field(i).Name, reader)
+		if err != nil && consumed {
+			return Decoder{Reader: reader, Type: "Tuple"}.Truncated(err)
+		}
+		if err != nil {
+			return err
+		}
+		consumed = consumed || !isZeroSized(tType.Field(i).Type)
+	}
+
+	return nil
+}
+
+func decodeTupleField(field reflect.Value, path string, reader io.Reader) error {
+	// named types of the same kind, e.g. Perbill, validate what they decode
+	if isScalarKind(field.Kind()) {
+		if decodable, ok := field.Addr().Interface().(Decodable); ok {
+			return newTupleFieldError(path, decodable.Decode(reader))
+		}
+	}
+
+	switch field.Kind() {
+	case reflect.Bool:
+		value, err := DecodeBool(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetBool(bool(value))
+	case reflect.Uint8:
+		value, err := DecodeU8(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetUint(uint64(value))
+	case reflect.Int8:
+		value, err := DecodeI8(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetInt(int64(value))
+	case reflect.Uint16:
+		value, err := DecodeU16(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetUint(uint64(value))
+	case reflect.Int16:
+		value, err := DecodeI16(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetInt(int64(value))
+	case reflect.Uint32:
+		value, err := DecodeU32(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetUint(uint64(value))
+	case reflect.Int32:
+		value, err := DecodeI32(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetInt(int64(value))
+	case reflect.Uint64:
+		value, err := DecodeU64(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetUint(uint64(value))
+	case reflect.Int64:
+		value, err := DecodeI64(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetInt(int64(value))
+	case reflect.String:
+		value, err := DecodeStr(reader)
+		if err != nil {
+			return newTupleFieldError(path, err)
+		}
+		field.SetString(string(value))
+	case reflect.Array:
+		// U128, I128, U256, I256
+		switch field.Type() {
+		case reflect.TypeOf(*new(U128)):
+			value, err := DecodeU128(reader)
+			if err != nil {
+				return newTupleFieldError(path, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		case reflect.TypeOf(*new(I128)):
+			value, err := DecodeI128(reader)
+			if err != nil {
+				return newTupleFieldError(path, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		case reflect.TypeOf(*new(U256)):
+			value, err := DecodeU256(reader)
+			if err != nil {
+				return newTupleFieldError(path, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		case reflect.TypeOf(*new(I256)):
+			value, err := DecodeI256(reader)
+			if err != nil {
+				return newTupleFieldError(path, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		default:
+			return decodeDecodableField(field, path, reader)
+		}
+	case reflect.Slice:
+		return decodeSequenceField(field, path, reader)
+	case reflect.Map:
+		if _, ok := field.Interface().(set); ok {
+			return decodeDecodableField(field, path, reader)
+		}
+		return decodeDictionaryField(field, path, reader)
+	case reflect.Struct:
+		switch field.Type() {
+		case reflect.TypeOf(*new(Empty)), reflect.TypeOf(*new(Tuple)):
+		case reflect.TypeOf(*new(Compact)):
+			value, err := DecodeCompact[U128](reader)
+			if err != nil {
+				return newTupleFieldError(path, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		default:
+			if _, ok := field.Interface().(optional); ok {
+				return decodeOptionField(field, path, reader)
+			}
+			if decodable, ok := field.Addr().Interface().(Decodable); ok {
+				return newTupleFieldError(path, decodable.Decode(reader))
+			}
+			// Tuple
+			return decodeTupleFields(field, path, reader)
+		}
+	case reflect.Interface:
+		// the embedded Encodable of the Tuple type carries no data
+	default:
+		return decodeDecodableField(field, path, reader)
+	}
+
+	return nil
+}
+
+func isScalarKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool, reflect.String,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeDecodableField(field reflect.Value, path string, reader io.Reader) error {
+	decodable, ok := field.Addr().Interface().(Decodable)
+	if !ok {
+		return newTupleFieldError(path, errTupleFieldNotSupported)
+	}
+	return newTupleFieldError(path, decodable.Decode(reader))
+}
+
+func decodeSequenceField(field reflect.Value, path string, reader io.Reader) error {
+	if field.Type() == reflect.TypeOf(*new(VaryingData)) {
+		// the variants are not known without decode funcs
+		return newTupleFieldError(path, errTupleFieldNotSupported)
+	}
+
+	// Sequence[U8], FixedSequence[U8] and Bytes are read in bulk
+	if elem := field.Type().Elem(); elem == reflect.TypeOf(*new(U8)) || elem == reflect.TypeOf(*new(byte)) {
+		if decodable, ok := field.Addr().Interface().(Decodable); ok {
+			return newTupleFieldError(path, decodable.Decode(reader))
+		}
+	}
+
+	if _, ok := field.Interface().(fixedSequence); ok {
+		// FixedSequenceOf[T, L] is allocated with its length, FixedSequence[T] beforehand
+		if l, ok := field.Interface().(fixedLength); ok {
+			field.Set(reflect.MakeSlice(field.Type(), l.fixedLength(), l.fixedLength()))
+		} else if field.Len() == 0 {
+			return newTupleFieldError(path, errFixedSequenceNoLen)
+		}
+		zeroSized := isZeroSized(field.Type().Elem())
+		for i := 0; i < field.Len(); i++ {
+			err := decodeTupleField(field.Index(i), path+"["+strconv.Itoa(i)+"]", reader)
+			if err != nil && i > 0 && !zeroSized {
+				return Decoder{Reader: reader, Type: "FixedSequence"}.Truncated(err)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	length, err := DecodeLength(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	err = checkBound(field, length)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	err = descend(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, length, field.Type().Elem())
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	values := reflect.MakeSlice(field.Type(), 0, preallocate(reader, length))
+	value := reflect.New(field.Type().Elem()).Elem()
+	for i := 0; i < length; i++ {
+		value.Set(reflect.Zero(value.Type()))
+		err := decodeTupleField(value, path+"["+strconv.Itoa(i)+"]", reader)
+		if err != nil {
+			return Decoder{Reader: reader, Type: "Sequence"}.Truncated(err)
+		}
+		values = reflect.Append(values, value)
+	}
+	field.Set(values)
+
+	return nil
+}
+
+// checkBound fails if the field is a bounded collection and length exceeds its bound.
+func checkBound(field reflect.Value, length int) error {
+	if b, ok := field.Interface().(bounded); ok && length > b.bound() {
+		return errBoundExceeded
+	}
+	return nil
+}
+
+func decodeDictionaryField(field reflect.Value, path string, reader io.Reader) error {
+	length, err := DecodeLength(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	err = checkBound(field, length)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	err = descend(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+	defer ascend(reader)
+
+	err = checkZeroSized(reader, length, field.Type().Key(), field.Type().Elem())
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	var less func(a, b reflect.Value) bool
+	if isStrict(reader) {
+		less = mapKeyLess(field)
+		if less == nil {
+			return newTupleFieldError(path, errTupleFieldNotSupported)
+		}
+	}
+
+	fieldType := field.Type()
+	values := reflect.MakeMapWithSize(fieldType, preallocate(reader, length))
+	var previous reflect.Value
+	for i := 0; i < length; i++ {
+		entryPath := path + "[" + strconv.Itoa(i) + "]"
+		keyPath := entryPath + ".key"
+
+		key := reflect.New(fieldType.Key()).Elem()
+		err := decodeTupleField(key, keyPath, reader)
+		if err != nil {
+			return Decoder{Reader: reader, Type: "Dictionary"}.Truncated(err)
+		}
+		if less != nil && i > 0 && !less(previous, key) {
+			return newTupleFieldError(keyPath, errDictionaryNotCanonical)
+		}
+		previous = key
+
+		value := reflect.New(fieldType.Elem()).Elem()
+		err = decodeTupleField(value, entryPath+".value", reader)
+		if err != nil {
+			return Decoder{Reader: reader, Type: "Dictionary"}.Truncated(err)
+		}
+
+		values.SetMapIndex(key, value)
+	}
+	field.Set(values)
+
+	return nil
+}
+
+func decodeOptionField(field reflect.Value, path string, reader io.Reader) error {
+	// Option[T] { HasValue Bool, Value T }
+	hasValue, err := DecodeBool(reader)
+	if err != nil {
+		return newTupleFieldError(path, err)
+	}
+
+	field.Field(0).SetBool(bool(hasValue))
+	if !hasValue {
+		field.Field(1).Set(reflect.Zero(field.Field(1).Type()))
+		return nil
+	}
+
+	err = decodeTupleField(field.Field(1), path, reader)
+	return Decoder{Reader: reader, Type: "Option"}.Truncated(err)
+}
@@ -2,8 +2,10 @@ package goscale
 
 import (
 	"bytes"
+	"io"
 	"math"
 	"math/big"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,8 +37,9 @@ func Test_EncodeTupleBool(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -68,8 +71,9 @@ func Test_EncodeTupleU8I8(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -101,8 +105,9 @@ func Test_EncodeTupleU16I16(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -134,8 +139,9 @@ func Test_EncodeTupleU32I32(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -167,8 +173,9 @@ func Test_EncodeTupleU64I64(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -203,13 +210,37 @@ func Test_EncodeTupleU128I128(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
 }
 
+type TupleU256I256 struct {
+	Tuple
+	G0 U256
+	G1 I256
+}
+
+func Test_TupleU256I256(t *testing.T) {
+	input := TupleU256I256{G0: U256{1, 0, 0, 2}, G1: NewI256(-1)}
+	expectation := append(append(append([]byte{0x01}, make([]byte, 23)...), 0x02, 0, 0, 0, 0, 0, 0, 0), bytes.Repeat([]byte{0xff}, 32)...)
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectation, buffer.Bytes())
+
+	result := TupleU256I256{}
+	err = DecodeTuple(&result, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
 type TupleCompactU128 struct {
 	Tuple
 	G0 Compact
@@ -239,13 +270,46 @@ func Test_EncodeTupleCompactU128(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
 }
 
+type TupleCompactOf struct {
+	Tuple
+	G0 CompactOf[U8]
+	G1 CompactOf[U32]
+	G2 CompactOf[U128]
+}
+
+func Test_TupleCompactOf(t *testing.T) {
+	input := TupleCompactOf{
+		G0: NewCompactOf(U8(255)),
+		G1: NewCompactOf(U32(1073741824)),
+		G2: NewCompactOf(NewU128(42)),
+	}
+	expectation := []byte{
+		0xfd, 0x03, // G0
+		0x03, 0x00, 0x00, 0x00, 0x40, // G1
+		0xa8, // G2
+	}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectation, buffer.Bytes())
+
+	result := TupleCompactOf{}
+	err = DecodeTuple(&result, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
 type TupleCompactU64 struct {
 	Tuple
 	G0 Compact
@@ -275,8 +339,9 @@ func Test_EncodeTupleCompactU64(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -311,8 +376,9 @@ func Test_EncodeTupleCompactU32(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -347,8 +413,9 @@ func Test_EncodeTupleCompactU16(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -383,8 +450,9 @@ func Test_EncodeTupleCompactU8(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -419,8 +487,9 @@ func Test_EncodeTupleStr(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -523,8 +592,9 @@ func Test_EncodeTupleSequence(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -554,13 +624,88 @@ func Test_EncodeTupleFixedSequence(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
 }
 
+func Test_DecodeTupleFixedSequence(t *testing.T) {
+	result := TupleFixedSequence{J0: make(FixedSequence[Bool], 3)}
+	err := DecodeTuple(&result, bytes.NewBuffer([]byte{0x01, 0x00, 0x01}))
+	assert.NoError(t, err)
+	assert.Equal(t, TupleFixedSequence{J0: FixedSequence[Bool]{true, false, true}}, result)
+
+	// like FixedSequence[T].Decode, the field must be allocated with its length
+	err = DecodeTuple(&TupleFixedSequence{}, bytes.NewBuffer([]byte{0x01, 0x00, 0x01}))
+	assert.Equal(t, &TupleFieldError{Path: "TupleFixedSequence.J0", Err: errFixedSequenceNoLen}, err)
+
+	err = DecodeTuple(&TupleBytes{}, bytes.NewBuffer([]byte{0x00, 0x00, 0x06, 0x07}))
+	assert.ErrorIs(t, err, errFixedSequenceNoLen)
+}
+
+type TupleFixedSequenceOf struct {
+	Tuple
+	J0 FixedSequenceOf[Bool, len3]
+	J1 FixedSequenceOf[TupleU8I8, len3]
+	J2 Bytes32
+}
+
+func Test_TupleFixedSequenceOf(t *testing.T) {
+	input := TupleFixedSequenceOf{
+		J0: FixedSequenceOf[Bool, len3]{true, false, true},
+		J1: FixedSequenceOf[TupleU8I8, len3]{{B0: 1, B1: -1}, {B0: 2, B1: -2}, {B0: 3, B1: -3}},
+		J2: Bytes32{31: 0xff},
+	}
+	expect := append([]byte{0x01, 0x00, 0x01, 0x01, 0xff, 0x02, 0xfe, 0x03, 0xfd}, input.J2[:]...)
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	// allocated by the decoder
+	result := TupleFixedSequenceOf{}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	input.J0 = input.J0[:2]
+	err = EncodeTuple(input, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errFixedSequenceLength)
+
+	input.J0 = FixedSequenceOf[Bool, len3]{true, false, true}
+	input.J1 = input.J1[:1]
+	err = EncodeTuple(input, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errFixedSequenceLength)
+}
+
+type TupleBytes struct {
+	Tuple
+	Code  Bytes
+	Data  Sequence[U8]
+	Nonce FixedSequence[U8]
+}
+
+func Test_TupleBytes(t *testing.T) {
+	input := TupleBytes{Code: Bytes{1, 2, 3}, Data: Sequence[U8]{4, 5}, Nonce: FixedSequence[U8]{6, 7}}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeTuple(input, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x0c, 0x01, 0x02, 0x03, 0x08, 0x04, 0x05, 0x06, 0x07}, buffer.Bytes())
+
+	result := TupleBytes{Nonce: make(FixedSequence[U8], 2)}
+	err = DecodeTuple(&result, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+
+	err = DecodeTuple(&TupleBytes{}, bytes.NewBuffer([]byte{0x0c, 0x01}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
 type TupleDictionary struct {
 	Tuple
 	K Dictionary[Str, U8]
@@ -589,8 +734,9 @@ func Test_EncodeTupleDictionary(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -624,8 +770,9 @@ func Test_EncodeTupleDictionaryCompact(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -660,8 +807,9 @@ func Test_EncodeTupleVaryingData(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -699,8 +847,9 @@ func Test_EncodeTupleOption(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -708,9 +857,9 @@ func Test_EncodeTupleOption(t *testing.T) {
 
 type TupleResult struct {
 	Tuple
-	N0 Result[U8]
-	N1 Result[Bool]
-	N2 Result[Str]
+	N0 Result[U8, Str]
+	N1 Result[Bool, U8]
+	N2 Result[Str, Str]
 }
 
 func Test_EncodeTupleResult(t *testing.T) {
@@ -722,14 +871,14 @@ func Test_EncodeTupleResult(t *testing.T) {
 		{
 			label: "TupleResult",
 			input: TupleResult{
-				N0: Result[U8]{true, 3},
-				N1: Result[Bool]{true, false},
-				N2: Result[Str]{true, "abc"},
+				N0: Ok[U8, Str](3),
+				N1: Err[Bool, U8](0),
+				N2: Err[Str, Str]("abc"),
 			},
 			expectation: []byte{
-				0x1, 0x03, // Result[U8]
-				0x01, 0x00, // Result[Bool]
-				0x01, 0x0c, 0x61, 0x62, 0x63, // Result[Str]
+				0x0, 0x03, // Result[U8, Str]
+				0x01, 0x00, // Result[Bool, U8]
+				0x01, 0x0c, 0x61, 0x62, 0x63, // Result[Str, Str]
 			},
 		},
 	}
@@ -738,8 +887,9 @@ func Test_EncodeTupleResult(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
@@ -787,7 +937,6 @@ type TupleAll struct {
 }
 
 func Test_EncodeTupleAll(t *testing.T) {
-	t.Skip()
 	var testExamples = []struct {
 		label       string
 		input       TupleAll
@@ -817,13 +966,152 @@ func Test_EncodeTupleAll(t *testing.T) {
 		t.Run(testExample.label, func(t *testing.T) {
 			buffer := &bytes.Buffer{}
 
-			EncodeTuple(testExample.input, buffer)
+			err := EncodeTuple(testExample.input, buffer)
 
+			assert.NoError(t, err)
 			assert.Equal(t, testExample.expectation, buffer.Bytes())
 		})
 	}
 }
 
+type TupleSequenceNested struct {
+	Tuple
+	R0 Sequence[Option[U8]]
+	R1 Sequence[TupleU8I8]
+	R2 FixedSequence[TupleU8I8]
+	R3 Dictionary[U8, Option[Bool]]
+}
+
+func Test_EncodeTupleSequenceNested(t *testing.T) {
+	input := TupleSequenceNested{
+		R0: Sequence[Option[U8]]{{true, 3}, {false, 0}},
+		R1: Sequence[TupleU8I8]{{B0: 1, B1: -1}},
+		R2: FixedSequence[TupleU8I8]{{B0: 2, B1: -2}},
+		R3: Dictionary[U8, Option[Bool]]{2: {false, false}, 1: {true, true}},
+	}
+	expect := []byte{
+		0x08, 0x01, 0x03, 0x00, // R0
+		0x04, 0x01, 0xff, // R1
+		0x02, 0xfe, // R2
+		0x08, 0x01, 0x01, 0x01, 0x02, 0x00, // R3
+	}
+	buffer := &bytes.Buffer{}
+
+	err := EncodeTuple(input, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expect, buffer.Bytes())
+
+	result := TupleSequenceNested{R2: make(FixedSequence[TupleU8I8], 1)}
+	err = DecodeTuple(&result, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
+type tupleInvalidNested struct {
+	Tuple
+	S0 U8
+	S1 Sequence[tupleInvalid]
+}
+
+type tupleInvalid struct {
+	Tuple
+	T0 int
+	T1 *U8
+}
+
+type tupleInvalidDictionary struct {
+	Tuple
+	D Dictionary[U8, tupleInvalid]
+}
+
+func Test_EncodeTuple_Errors(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		input  interface{}
+		expect error
+		path   string
+	}{
+		{
+			label:  "not a struct",
+			input:  U8(1),
+			expect: errNotTuple,
+		},
+		{
+			label:  "not supported field",
+			input:  tupleInvalid{},
+			expect: errTupleFieldNotSupported,
+			path:   "tupleInvalid.T0",
+		},
+		{
+			label:  "not implemented field",
+			input:  struct{ T1 *U8 }{},
+			expect: errTupleFieldNotImplemented,
+			path:   ".T1",
+		},
+		{
+			label:  "nested field",
+			input:  tupleInvalidNested{S1: Sequence[tupleInvalid]{{}, {}}},
+			expect: errTupleFieldNotSupported,
+			path:   "tupleInvalidNested.S1[0].T0",
+		},
+		{
+			label:  "dictionary value",
+			input:  tupleInvalidDictionary{D: Dictionary[U8, tupleInvalid]{1: {}}},
+			expect: errTupleFieldNotSupported,
+			path:   "tupleInvalidDictionary.D[0].value.T0",
+		},
+		{
+			label:  "field of a named type",
+			input:  struct{ A uint8 }{},
+			expect: errTupleFieldNotSupported,
+			path:   ".A",
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := EncodeTuple(testExample.input, buffer)
+
+			assert.ErrorIs(t, err, testExample.expect)
+			if testExample.path != "" {
+				var fieldErr *TupleFieldError
+				assert.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, testExample.path, fieldErr.Path)
+			}
+		})
+	}
+}
+
+func Test_SequenceFieldEncode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := SequenceFieldEncode(reflect.ValueOf(Sequence[Sequence[U8]]{{1, 2}, {}}), buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x08, 0x08, 0x01, 0x02, 0x00}, buffer.Bytes())
+}
+
+func Test_DictionaryFieldEncode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := DictionaryFieldEncode(reflect.ValueOf(Dictionary[I8, U8]{1: 3, -1: 5}), buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x08, 0xff, 0x05, 0x01, 0x03}, buffer.Bytes())
+}
+
+func Test_DictionaryFieldEncode_Error(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := DictionaryFieldEncode(reflect.ValueOf(map[bool]U8{true: 1}), buffer)
+
+	assert.ErrorIs(t, err, errTupleFieldNotSupported)
+}
+
 func Test_TupleEncodablePanics(t *testing.T) {
 	type testTuple struct {
 		Tuple
@@ -838,3 +1126,143 @@ func Test_TupleEncodablePanics(t *testing.T) {
 		tuple.Bytes()
 	})
 }
+
+type TupleDecodable struct {
+	Tuple
+	A Bool
+	B U8
+	C I16
+	D U32
+	E I64
+	F U128
+	G I128
+	H Compact
+	I Str
+	J Sequence[Sequence[Bool]]
+	K FixedSequence[U16]
+	L Dictionary[Str, U8]
+	M Option[U8]
+	N Option[Str]
+	O Result[U8, U8]
+	P Empty
+	Q TupleU8I8
+	r U8
+}
+
+func Test_DecodeTuple(t *testing.T) {
+	input := []byte{
+		0x01,       // A
+		0xff,       // B
+		0x80, 0xff, // C
+		0x01, 0x00, 0x00, 0x00, // D
+		0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, // E
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, // F
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, // G
+		0x15, 0x01, // H
+		0x0c, 0x61, 0x62, 0x63, // I
+		0x08, 0x08, 0x01, 0x00, 0x04, 0x01, // J
+		0x01, 0x00, 0x02, 0x00, // K
+		0x08, 0x0c, 0x61, 0x62, 0x63, 0x03, 0x0c, 0x78, 0x79, 0x7a, 0x05, // L
+		0x01, 0x07, // M
+		0x00,       // N
+		0x01, 0x02, // O
+		0x01, 0x02, // Q
+	}
+	expect := TupleDecodable{
+		A: true,
+		B: 255,
+		C: -128,
+		D: 1,
+		E: -2,
+		F: MaxU128(),
+		G: NewI128(-1),
+		H: Compact{NewU128(69)},
+		I: "abc",
+		J: Sequence[Sequence[Bool]]{{true, false}, {true}},
+		K: FixedSequence[U16]{1, 2},
+		L: Dictionary[Str, U8]{"abc": 3, "xyz": 5},
+		M: Option[U8]{true, 7},
+		N: Option[Str]{false, ""},
+		O: Err[U8, U8](2),
+		Q: TupleU8I8{B0: 1, B1: 2},
+	}
+
+	buffer := bytes.NewBuffer(input)
+	result := TupleDecodable{K: make(FixedSequence[U16], 2), N: Option[Str]{true, "xyz"}}
+
+	err := DecodeTuple(&result, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expect, result)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func Test_DecodeTuple_RoundTrip(t *testing.T) {
+	input := TupleOption{
+		M0: Option[U8]{true, 3},
+		M1: Option[Bool]{false, false},
+		M2: Option[Str]{true, "abc"},
+	}
+	buffer := &bytes.Buffer{}
+	EncodeTuple(input, buffer)
+
+	result := TupleOption{}
+	err := DecodeTuple(&result, buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, result)
+}
+
+func Test_DecodeTuple_DictionaryPaths(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		input  []byte
+		expect error
+		path   string
+	}{
+		{label: "truncated key", input: []byte{0x04}, expect: io.ErrUnexpectedEOF, path: ".D[0].key"},
+		{label: "truncated value", input: []byte{0x04, 0x01, 0x01}, expect: io.ErrUnexpectedEOF, path: ".D[0].value"},
+		{label: "second value", input: []byte{0x08, 0x01, 0x01, 0x00, 0x02}, expect: io.ErrUnexpectedEOF, path: ".D[1].value"},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			target := &struct{ D Dictionary[U8, U16] }{}
+
+			err := DecodeTuple(target, bytes.NewBuffer(testExample.input))
+
+			assert.ErrorIs(t, err, testExample.expect)
+			var fieldErr *TupleFieldError
+			assert.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, testExample.path, fieldErr.Path)
+		})
+	}
+}
+
+func Test_DecodeTuple_Errors(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		target interface{}
+		input  []byte
+		expect error
+	}{
+		{label: "not a pointer", target: TupleBool{}, expect: errNotTuplePointer},
+		{label: "nil pointer", target: (*TupleBool)(nil), expect: errNotTuplePointer},
+		{label: "not a struct", target: new(U8), expect: errNotTuplePointer},
+		{label: "unsupported field", target: &struct{ A int }{}, expect: errTupleFieldNotSupported},
+		{label: "VaryingData field", target: &TupleVaryingData{}, input: []byte{0x00}, expect: errTupleFieldNotSupported},
+		{label: "invalid Bool", target: &TupleBool{}, input: []byte{0x02}, expect: errInvalidBoolRepresentation},
+		{label: "missing bytes", target: &TupleBool{}, input: []byte{}, expect: io.EOF},
+		{label: "missing field", target: &TupleBool{}, input: []byte{0x01}, expect: io.ErrUnexpectedEOF},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(testExample.input)
+
+			err := DecodeTuple(testExample.target, buffer)
+
+			assert.ErrorIs(t, err, testExample.expect)
+		})
+	}
+}
@@ -1,8 +1,8 @@
 package goscale
 
 import (
-	"bytes"
 	"encoding/binary"
+	"io"
 	"math/big"
 	"math/bits"
 )
@@ -12,6 +12,7 @@ import (
 // [1] most significant bits
 type U128 [2]U64
 
+// NewU128 converts n to U128, the values out of range wrap around like the conversions of the Go integers.
 func NewU128[N Integer](n N) U128 {
 	return anyIntegerTo128Bits[U128](n)
 }
@@ -20,12 +21,12 @@ func NewU128FromString(n string) (U128, error) {
 	return stringTo128Bits[U128](n)
 }
 
-func (n U128) Encode(buffer *bytes.Buffer) error {
-	err := n[0].Encode(buffer)
+func (n U128) Encode(writer io.Writer) error {
+	err := n[0].Encode(writer)
 	if err != nil {
 		return err
 	}
-	err = n[1].Encode(buffer)
+	err = n[1].Encode(writer)
 	if err != nil {
 		return err
 	}
@@ -36,8 +37,16 @@ func (n U128) Bytes() []byte {
 	return append(n[0].Bytes(), n[1].Bytes()...)
 }
 
-func DecodeU128(buffer *bytes.Buffer) (U128, error) {
-	decoder := Decoder{Reader: buffer}
+func (n U128) EncodedLen() int {
+	return 16
+}
+
+func (n U128) MaxEncodedLen() int {
+	return 16
+}
+
+func DecodeU128(reader io.Reader) (U128, error) {
+	decoder := Decoder{Reader: reader, Type: "U128"}
 	buf := make([]byte, 16)
 	err := decoder.Read(buf)
 	if err != nil {
@@ -50,6 +59,15 @@ func DecodeU128(buffer *bytes.Buffer) (U128, error) {
 	}, nil
 }
 
+func (n *U128) Decode(reader io.Reader) error {
+	result, err := DecodeU128(reader)
+	if err != nil {
+		return err
+	}
+	*n = result
+	return nil
+}
+
 func (n U128) ToBigInt() *big.Int {
 	bytes := make([]byte, 16)
 	binary.BigEndian.PutUint64(bytes[:8], uint64(n[1]))
@@ -76,19 +94,17 @@ func (n U128) Mul(other U128) U128 {
 }
 
 func (n U128) Div(other U128) U128 {
-	return bigIntToU128(
-		new(big.Int).Div(n.ToBigInt(), other.ToBigInt()),
-	)
+	quotient, _ := divMod128(n, other)
+	return quotient
 }
 
 func (n U128) Mod(other U128) U128 {
-	return bigIntToU128(
-		new(big.Int).Mod(n.ToBigInt(), other.ToBigInt()),
-	)
+	_, remainder := divMod128(n, other)
+	return remainder
 }
 
 func (n U128) Eq(other U128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) == 0
+	return n == other
 }
 
 func (n U128) Ne(other U128) bool {
@@ -96,36 +112,102 @@ func (n U128) Ne(other U128) bool {
 }
 
 func (n U128) Lt(other U128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) < 0
+	return cmp128(n, other) < 0
 }
 
 func (n U128) Lte(other U128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) <= 0
+	return cmp128(n, other) <= 0
 }
 
 func (n U128) Gt(other U128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) > 0
+	return cmp128(n, other) > 0
 }
 
 func (n U128) Gte(other U128) bool {
-	return n.ToBigInt().Cmp(other.ToBigInt()) >= 0
+	return cmp128(n, other) >= 0
+}
+
+func (n U128) SaturatingAdd(other U128) U128 {
+	return SaturatingAdd(n, other)
+}
+
+func (n U128) SaturatingSub(other U128) U128 {
+	return SaturatingSub(n, other)
+}
+
+func (n U128) SaturatingMul(other U128) U128 {
+	return SaturatingMul(n, other)
+}
+
+func (n U128) WrappingAdd(other U128) U128 {
+	return n.Add(other)
+}
+
+func (n U128) WrappingSub(other U128) U128 {
+	return n.Sub(other)
+}
+
+func (n U128) WrappingMul(other U128) U128 {
+	return n.Mul(other)
+}
+
+// cmp128 compares the limbs as unsigned integers.
+func cmp128(a, b [2]U64) int {
+	switch {
+	case a[1] < b[1]:
+		return -1
+	case a[1] > b[1]:
+		return 1
+	case a[0] < b[0]:
+		return -1
+	case a[0] > b[0]:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// divMod128 returns the quotient and the remainder of the unsigned division,
+// it panics if the divisor is zero like the integer division does.
+func divMod128(n, d [2]U64) (U128, U128) {
+	if d[1] == 0 {
+		// 128 by 64 bits division, one or two steps of bits.Div64
+		if n[1] < d[0] {
+			q, r := bits.Div64(uint64(n[1]), uint64(n[0]), uint64(d[0]))
+			return U128{U64(q)}, U128{U64(r)}
+		}
+		qHigh, r := bits.Div64(0, uint64(n[1]), uint64(d[0]))
+		qLow, r := bits.Div64(r, uint64(n[0]), uint64(d[0]))
+		return U128{U64(qLow), U64(qHigh)}, U128{U64(r)}
+	}
+
+	// the quotient fits in 64 bits, estimate it from the normalized divisor,
+	// the estimate is at most one less than the quotient
+	s := uint(bits.LeadingZeros64(uint64(d[1])))
+	dHigh := uint64(d[1])<<s | uint64(d[0])>>(64-s)
+	nHigh, nLow := uint64(n[1])>>1, uint64(n[0])>>1|uint64(n[1])<<63
+	q, _ := bits.Div64(nHigh, nLow, dHigh)
+	q >>= 63 - s
+	if q != 0 {
+		q--
+	}
+
+	quotient := U128{U64(q)}
+	remainder := U128(n).Sub(quotient.Mul(d))
+	if cmp128(remainder, d) >= 0 {
+		quotient = quotient.Add(U128{1})
+		remainder = remainder.Sub(d)
+	}
+	return quotient, remainder
 }
 
-//func (n U128) SaturatingMul(other U128) U128 {
-//	result := new(big.Int).Mul(n.ToBigInt(), other.ToBigInt())
-//	// check for overflow
-//	maxValue := new(big.Int)
-//	maxValue.Lsh(big.NewInt(1), 128)
-//	maxValue.Sub(maxValue, big.NewInt(1))
-//	if result.Cmp(maxValue) > 0 {
-//		result.Set(maxValue)
-//	}
-//	return bigIntToU128(result)
-//}
+var maxU128BigInt = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
 
+// bigIntToU128 wraps the values out of range around like bigIntToU256,
+// the negative values are represented in two's complement.
 func bigIntToU128(n *big.Int) U128 {
 	bytes := make([]byte, 16)
-	n.FillBytes(bytes)
+	new(big.Int).And(n, maxU128BigInt).FillBytes(bytes)
 	reverseSlice(bytes)
 
 	return U128{
@@ -5,6 +5,7 @@ import (
 	"io"
 	"math"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -71,14 +72,21 @@ func Test_U128_Decode(t *testing.T) {
 	}
 }
 
-func Test_NewU128FromBigIntPanic(t *testing.T) {
+func Test_NewU128FromBigIntWraps(t *testing.T) {
 	t.Run("Exceeds U128", func(t *testing.T) {
-		value, ok := new(big.Int).SetString("340282366920938463463374607431768211456", 10) // MaxU128 + 1
+		value, ok := new(big.Int).SetString("340282366920938463463374607431768211457", 10) // MaxU128 + 2
 		if !ok {
 			panic("not ok")
 		}
 
-		assert.Panics(t, func() { NewU128(value) })
+		assert.Equal(t, NewU128(1), NewU128(value))
+	})
+
+	t.Run("U256", func(t *testing.T) {
+		assert.Equal(t, MaxU128(), NewU128(MaxU256()))
+		value, err := NewU256FromString("340282366920938463463374607431768211461") // 2^128 + 5
+		assert.NoError(t, err)
+		assert.Equal(t, NewU128(5), NewU128(value))
 	})
 }
 
@@ -313,3 +321,101 @@ func Test_DecodeU128_Empty(t *testing.T) {
 	assert.Equal(t, io.EOF, err)
 	assert.Equal(t, U128{}, result)
 }
+
+// u128Operands are edge cases and random values with limbs of different magnitudes
+func u128Operands() []U128 {
+	operands := []U128{
+		{0, 0}, {1, 0}, {2, 0}, {math.MaxUint64, 0}, {0, 1}, {1, 1},
+		{math.MaxUint64, 1}, {0, math.MaxUint64}, {math.MaxUint64 - 1, math.MaxUint64}, MaxU128(),
+		{0, 1 << 63}, {math.MaxUint64, math.MaxUint64 >> 1},
+	}
+
+	random := rand.New(rand.NewSource(42))
+	for i := 0; i < 64; i++ {
+		operands = append(operands,
+			U128{U64(random.Uint64()), U64(random.Uint64())},
+			U128{U64(random.Uint64()), U64(random.Uint64() >> random.Intn(64))},
+			U128{U64(random.Uint64() >> random.Intn(64)), 0},
+		)
+	}
+	return operands
+}
+
+func Test_U128_MatchesBigInt(t *testing.T) {
+	operands := u128Operands()
+
+	for _, a := range operands {
+		for _, b := range operands {
+			cmp := a.ToBigInt().Cmp(b.ToBigInt())
+			assert.Equal(t, cmp == 0, a.Eq(b))
+			assert.Equal(t, cmp != 0, a.Ne(b))
+			assert.Equal(t, cmp < 0, a.Lt(b))
+			assert.Equal(t, cmp <= 0, a.Lte(b))
+			assert.Equal(t, cmp > 0, a.Gt(b))
+			assert.Equal(t, cmp >= 0, a.Gte(b))
+
+			if b == (U128{}) {
+				continue
+			}
+			assert.Equal(t, bigIntToU128(new(big.Int).Div(a.ToBigInt(), b.ToBigInt())), a.Div(b), "%v / %v", a, b)
+			assert.Equal(t, bigIntToU128(new(big.Int).Mod(a.ToBigInt(), b.ToBigInt())), a.Mod(b), "%v %% %v", a, b)
+		}
+	}
+}
+
+func Test_U128_DivByZero(t *testing.T) {
+	assert.Panics(t, func() { NewU128(1).Div(NewU128(0)) })
+	assert.Panics(t, func() { NewU128(1).Mod(NewU128(0)) })
+}
+
+func Test_U128_NoAllocations(t *testing.T) {
+	a := U128{12345, 6789}
+	b := U128{987654321, 3}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		a.Div(b)
+		a.Mod(b)
+		a.Div(U128{7})
+		a.Lt(b)
+		a.Eq(b)
+		a.Gte(b)
+	})
+
+	assert.Equal(t, float64(0), allocs)
+}
+
+func Benchmark_U128_Div(b *testing.B) {
+	x := U128{math.MaxUint64, math.MaxUint64 >> 3}
+	y := U128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Div(y)
+	}
+}
+
+func Benchmark_U128_Div64(b *testing.B) {
+	x := U128{math.MaxUint64, math.MaxUint64 >> 3}
+	y := U128{987654321}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Div(y)
+	}
+}
+
+func Benchmark_U128_Mod(b *testing.B) {
+	x := U128{math.MaxUint64, math.MaxUint64 >> 3}
+	y := U128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Mod(y)
+	}
+}
+
+func Benchmark_U128_Lt(b *testing.B) {
+	x := U128{math.MaxUint64, 3}
+	y := U128{987654321, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Lt(y)
+	}
+}
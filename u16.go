@@ -1,15 +1,15 @@
 package goscale
 
 import (
-	"bytes"
 	"encoding/binary"
+	"io"
 	"math/big"
 )
 
 type U16 uint16
 
-func (value U16) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (value U16) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(value.Bytes())
 }
 
@@ -28,8 +28,16 @@ func (value U16) Bytes() []byte {
 	return result
 }
 
-func DecodeU16(buffer *bytes.Buffer) (U16, error) {
-	decoder := Decoder{Reader: buffer}
+func (value U16) EncodedLen() int {
+	return 2
+}
+
+func (value U16) MaxEncodedLen() int {
+	return 2
+}
+
+func DecodeU16(reader io.Reader) (U16, error) {
+	decoder := Decoder{Reader: reader, Type: "U16"}
 	result := make([]byte, 2)
 	err := decoder.Read(result)
 	if err != nil {
@@ -37,3 +45,12 @@ func DecodeU16(buffer *bytes.Buffer) (U16, error) {
 	}
 	return U16(binary.LittleEndian.Uint16(result)), nil
 }
+
+func (value *U16) Decode(reader io.Reader) error {
+	result, err := DecodeU16(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
@@ -0,0 +1,210 @@
+package goscale
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+	"math/bits"
+)
+
+// little endian byte order
+// [0] least significant bits
+// [3] most significant bits
+type U256 [4]U64
+
+var maxU256BigInt = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// NewU256 converts n to U256, the values out of range wrap around like the conversions of the Go integers.
+func NewU256[N Integer](n N) U256 {
+	return anyIntegerTo256Bits[U256](n)
+}
+
+// NewU256FromString fails if n is negative or exceeds MaxU256.
+func NewU256FromString(n string) (U256, error) {
+	return stringTo256Bits[U256](n)
+}
+
+func (n U256) Encode(writer io.Writer) error {
+	for _, limb := range n {
+		err := limb.Encode(writer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n U256) Bytes() []byte {
+	bytes := make([]byte, 32)
+	putLimbs256(bytes, n)
+	return bytes
+}
+
+func (n U256) EncodedLen() int {
+	return 32
+}
+
+func (n U256) MaxEncodedLen() int {
+	return 32
+}
+
+func DecodeU256(reader io.Reader) (U256, error) {
+	decoder := Decoder{Reader: reader, Type: "U256"}
+	buf := make([]byte, 32)
+	err := decoder.Read(buf)
+	if err != nil {
+		return U256{}, err
+	}
+	return limbs256(buf), nil
+}
+
+func (n *U256) Decode(reader io.Reader) error {
+	result, err := DecodeU256(reader)
+	if err != nil {
+		return err
+	}
+	*n = result
+	return nil
+}
+
+func (n U256) ToBigInt() *big.Int {
+	bytes := make([]byte, 32)
+	putLimbs256(bytes, n)
+	reverseSlice(bytes)
+	return big.NewInt(0).SetBytes(bytes)
+}
+
+func (n U256) Add(other U256) U256 {
+	sum, _ := add256(n, other)
+	return sum
+}
+
+func (n U256) Sub(other U256) U256 {
+	diff, _ := sub256(n, other)
+	return diff
+}
+
+func (n U256) Mul(other U256) U256 {
+	low, _ := mul256(n, other)
+	return low
+}
+
+func (n U256) Div(other U256) U256 {
+	return bigIntToU256(
+		new(big.Int).Div(n.ToBigInt(), other.ToBigInt()),
+	)
+}
+
+func (n U256) Mod(other U256) U256 {
+	return bigIntToU256(
+		new(big.Int).Mod(n.ToBigInt(), other.ToBigInt()),
+	)
+}
+
+func (n U256) Eq(other U256) bool {
+	return n == other
+}
+
+func (n U256) Ne(other U256) bool {
+	return !n.Eq(other)
+}
+
+func (n U256) Lt(other U256) bool {
+	return cmp256(n, other) < 0
+}
+
+func (n U256) Lte(other U256) bool {
+	return cmp256(n, other) <= 0
+}
+
+func (n U256) Gt(other U256) bool {
+	return cmp256(n, other) > 0
+}
+
+func (n U256) Gte(other U256) bool {
+	return cmp256(n, other) >= 0
+}
+
+// bigIntToU256 wraps the values out of range around like the conversions
+// of the Go integers, the negative values are represented in two's complement.
+func bigIntToU256(n *big.Int) U256 {
+	bytes := make([]byte, 32)
+	new(big.Int).And(n, maxU256BigInt).FillBytes(bytes)
+	reverseSlice(bytes)
+	return limbs256(bytes)
+}
+
+// limbs256 reads 32 little endian bytes into limbs.
+func limbs256(bytes []byte) [4]U64 {
+	return [4]U64{
+		U64(binary.LittleEndian.Uint64(bytes[:8])),
+		U64(binary.LittleEndian.Uint64(bytes[8:16])),
+		U64(binary.LittleEndian.Uint64(bytes[16:24])),
+		U64(binary.LittleEndian.Uint64(bytes[24:])),
+	}
+}
+
+// putLimbs256 writes the limbs into 32 little endian bytes.
+func putLimbs256(bytes []byte, n [4]U64) {
+	for i, limb := range n {
+		binary.LittleEndian.PutUint64(bytes[8*i:], uint64(limb))
+	}
+}
+
+// add256 returns the sum modulo 2^256 and the carry out of the most significant limb.
+func add256(a, b [4]U64) ([4]U64, uint64) {
+	var sum [4]U64
+	var carry uint64
+	for i := range sum {
+		var limb uint64
+		limb, carry = bits.Add64(uint64(a[i]), uint64(b[i]), carry)
+		sum[i] = U64(limb)
+	}
+	return sum, carry
+}
+
+// sub256 returns the difference modulo 2^256 and the borrow out of the most significant limb.
+func sub256(a, b [4]U64) ([4]U64, uint64) {
+	var diff [4]U64
+	var borrow uint64
+	for i := range diff {
+		var limb uint64
+		limb, borrow = bits.Sub64(uint64(a[i]), uint64(b[i]), borrow)
+		diff[i] = U64(limb)
+	}
+	return diff, borrow
+}
+
+// mul256 returns the low and the high 256 bits of the full 512 bits product.
+func mul256(a, b [4]U64) (U256, U256) {
+	var product [8]uint64
+	for i := 0; i < 4; i++ {
+		var carry uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(uint64(a[i]), uint64(b[j]))
+			var c uint64
+			lo, c = bits.Add64(lo, product[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			product[i+j] = lo
+			carry = hi
+		}
+		product[i+4] = carry
+	}
+	return U256{U64(product[0]), U64(product[1]), U64(product[2]), U64(product[3])},
+		U256{U64(product[4]), U64(product[5]), U64(product[6]), U64(product[7])}
+}
+
+// cmp256 compares the limbs as unsigned integers.
+func cmp256(a, b [4]U64) int {
+	for i := 3; i >= 0; i-- {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	return 0
+}
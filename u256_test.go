@@ -0,0 +1,180 @@
+package goscale
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_U256_Encode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  string
+		expect []byte
+	}{
+		{label: "Encode U256 - (0)", input: "0", expect: make([]byte, 32)},
+		{label: "Encode U256 - (42)", input: "42", expect: append([]byte{0x2a}, make([]byte, 31)...)},
+		{label: "Encode U256 - (MaxU128 + 1)", input: "340282366920938463463374607431768211456", expect: append(append(make([]byte, 16), 0x01), make([]byte, 15)...)},
+		{label: "Encode U256 - (MaxU256)", input: "115792089237316195423570985008687907853269984665640564039457584007913129639935", expect: bytes.Repeat([]byte{0xff}, 32)},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			input, err := NewU256FromString(e.input)
+			assert.NoError(t, err)
+
+			err = input.Encode(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, buffer.Bytes())
+			assert.Equal(t, e.expect, input.Bytes())
+		})
+	}
+}
+
+func Test_U256_Decode(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect U256
+	}{
+		{label: "Decode U256 - (0)", input: make([]byte, 32), expect: U256{}},
+		{label: "Decode U256 - (42)", input: append([]byte{0x2a}, make([]byte, 31)...), expect: U256{42}},
+		{label: "Decode U256 - (2^192)", input: append(append(make([]byte, 24), 0x01), make([]byte, 7)...), expect: U256{0, 0, 0, 1}},
+		{label: "Decode U256 - (MaxU256)", input: bytes.Repeat([]byte{0xff}, 32), expect: MaxU256()},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(e.input)
+
+			result, err := DecodeU256(buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+		})
+	}
+}
+
+func Test_DecodeU256_Empty(t *testing.T) {
+	result, err := DecodeU256(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, U256{}, result)
+}
+
+func Test_NewU256(t *testing.T) {
+	assert.Equal(t, U256{42}, NewU256(uint8(42)))
+	assert.Equal(t, U256{math.MaxUint64}, NewU256(U64(math.MaxUint64)))
+	assert.Equal(t, U256{1, 1}, NewU256(U128{1, 1}))
+	assert.Equal(t, U256{0, 0, 0, 1}, NewU256(new(big.Int).Lsh(big.NewInt(1), 192)))
+
+	_, err := NewU256FromString("test")
+	assert.Error(t, err)
+
+	// the values out of range wrap around
+	exceeds := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(5))
+	assert.Equal(t, U256{5}, NewU256(exceeds))
+	assert.Equal(t, MaxU256(), NewU256(big.NewInt(-1)))
+	assert.Equal(t, MaxU256(), NewU256(int8(-1)))
+}
+
+func Test_NewU256FromString_OutOfRange(t *testing.T) {
+	var examples = []struct {
+		label  string
+		input  string
+		expect error
+	}{
+		{label: "MaxU256 + 1", input: "115792089237316195423570985008687907853269984665640564039457584007913129639936", expect: errOverflow},
+		{label: "-1", input: "-1", expect: errUnderflow},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := NewU256FromString(e.input)
+
+			assert.Equal(t, e.expect, err)
+			assert.Equal(t, U256{}, result)
+		})
+	}
+}
+
+func Test_ToCompact_NegativeI256(t *testing.T) {
+	assert.PanicsWithValue(t, "negative value in ToCompact()", func() { ToCompact(NewI256(-5)) })
+	assert.Equal(t, []byte{0x14}, ToCompact(NewI256(5)).Bytes())
+}
+
+func Test_U256_ToBigInt(t *testing.T) {
+	expect, _ := new(big.Int).SetString("6277101735386680763835789423207666416102355444464034512896", 10) // 2^192
+
+	assert.Equal(t, expect, U256{0, 0, 0, 1}.ToBigInt())
+	assert.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), MaxU256().ToBigInt())
+}
+
+func Test_U256_Arithmetic(t *testing.T) {
+	a, _ := NewU256FromString("57896044618658097711785492504343953926634992332820282019728792003956564819967")
+	b, _ := NewU256FromString("340282366920938463463374607431768211457")
+
+	testExamples := []struct {
+		label     string
+		operation func(a, b U256) U256
+		bigOp     func(z, a, b *big.Int) *big.Int
+	}{
+		{"Add", U256.Add, (*big.Int).Add},
+		{"Sub", U256.Sub, (*big.Int).Sub},
+		{"Mul", U256.Mul, (*big.Int).Mul},
+		{"Div", U256.Div, (*big.Int).Div},
+		{"Mod", U256.Mod, (*big.Int).Mod},
+	}
+
+	modulus := new(big.Int).Lsh(big.NewInt(1), 256)
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			for _, operands := range [][2]U256{{a, b}, {b, a}, {MaxU256(), MaxU256()}, {MaxU256(), NewU256(2)}} {
+				expect := testExample.bigOp(new(big.Int), operands[0].ToBigInt(), operands[1].ToBigInt())
+				expect.Mod(expect, modulus)
+
+				result := testExample.operation(operands[0], operands[1])
+
+				assert.Equal(t, expect.String(), result.ToBigInt().String())
+			}
+		})
+	}
+}
+
+func Test_U256_Compare(t *testing.T) {
+	small := U256{math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	large := U256{0, 0, 0, 1}
+
+	assert.True(t, small.Lt(large))
+	assert.True(t, small.Lte(large))
+	assert.True(t, large.Gt(small))
+	assert.True(t, large.Gte(small))
+	assert.True(t, large.Gte(large))
+	assert.True(t, large.Lte(large))
+	assert.True(t, large.Eq(large))
+	assert.True(t, large.Ne(small))
+	assert.False(t, large.Lt(large))
+	assert.False(t, small.Gt(large))
+}
+
+func Test_U256_Compact(t *testing.T) {
+	value := U256{0, 0, 0, 1}
+
+	compact := ToCompact(value)
+	assert.Equal(t, append([]byte{0x57}, append(make([]byte, 24), 0x01)...), compact.Bytes())
+
+	result, err := DecodeCompact[U256](bytes.NewBuffer(compact.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, Compact{value}, result)
+
+	result, err = DecodeCompact[U256](bytes.NewBuffer([]byte{0xa8}))
+	assert.NoError(t, err)
+	assert.Equal(t, Compact{U256{42}}, result)
+}
@@ -1,15 +1,15 @@
 package goscale
 
 import (
-	"bytes"
 	"encoding/binary"
+	"io"
 	"math/big"
 )
 
 type U32 uint32
 
-func (value U32) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (value U32) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(value.Bytes())
 }
 
@@ -20,6 +20,14 @@ func (value U32) Bytes() []byte {
 	return result
 }
 
+func (value U32) EncodedLen() int {
+	return 4
+}
+
+func (value U32) MaxEncodedLen() int {
+	return 4
+}
+
 func NewU32(n uint32) U32 {
 	return U32(n)
 }
@@ -28,8 +36,8 @@ func (value U32) ToBigInt() *big.Int {
 	return new(big.Int).SetUint64(uint64(value))
 }
 
-func DecodeU32(buffer *bytes.Buffer) (U32, error) {
-	decoder := Decoder{Reader: buffer}
+func DecodeU32(reader io.Reader) (U32, error) {
+	decoder := Decoder{Reader: reader, Type: "U32"}
 	result := make([]byte, 4)
 	err := decoder.Read(result)
 	if err != nil {
@@ -37,3 +45,12 @@ func DecodeU32(buffer *bytes.Buffer) (U32, error) {
 	}
 	return U32(binary.LittleEndian.Uint32(result)), nil
 }
+
+func (value *U32) Decode(reader io.Reader) error {
+	result, err := DecodeU32(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
@@ -1,15 +1,15 @@
 package goscale
 
 import (
-	"bytes"
 	"encoding/binary"
+	"io"
 	"math/big"
 )
 
 type U64 uint64
 
-func (value U64) Encode(buffer *bytes.Buffer) error {
-	encoder := Encoder{Writer: buffer}
+func (value U64) Encode(writer io.Writer) error {
+	encoder := Encoder{Writer: writer}
 	return encoder.Write(value.Bytes())
 }
 
@@ -20,6 +20,14 @@ func (value U64) Bytes() []byte {
 	return result
 }
 
+func (value U64) EncodedLen() int {
+	return 8
+}
+
+func (value U64) MaxEncodedLen() int {
+	return 8
+}
+
 func NewU64(n uint64) U64 {
 	return U64(n)
 }
@@ -28,8 +36,8 @@ func (value U64) ToBigInt() *big.Int {
 	return new(big.Int).SetUint64(uint64(value))
 }
 
-func DecodeU64(buffer *bytes.Buffer) (U64, error) {
-	decoder := Decoder{Reader: buffer}
+func DecodeU64(reader io.Reader) (U64, error) {
+	decoder := Decoder{Reader: reader, Type: "U64"}
 	result := make([]byte, 8)
 	err := decoder.Read(result)
 	if err != nil {
@@ -37,3 +45,12 @@ func DecodeU64(buffer *bytes.Buffer) (U64, error) {
 	}
 	return U64(binary.LittleEndian.Uint64(result)), nil
 }
+
+func (value *U64) Decode(reader io.Reader) error {
+	result, err := DecodeU64(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
@@ -1,15 +1,15 @@
 package goscale
 
 import (
-	"bytes"
+	"io"
 	"math/big"
 )
 
 type U8 uint8
 
-func (value U8) Encode(buffer *bytes.Buffer) error {
+func (value U8) Encode(writer io.Writer) error {
 	// do not use value.Bytes() here: https://github.com/LimeChain/goscale/issues/77
-	encoder := Encoder{Writer: buffer}
+	encoder := Encoder{Writer: writer}
 	return encoder.EncodeByte(byte(value))
 }
 
@@ -17,6 +17,14 @@ func (value U8) Bytes() []byte {
 	return []byte{byte(value)}
 }
 
+func (value U8) EncodedLen() int {
+	return 1
+}
+
+func (value U8) MaxEncodedLen() int {
+	return 1
+}
+
 func NewU8(n uint8) U8 {
 	return U8(n)
 }
@@ -25,8 +33,17 @@ func (value U8) ToBigInt() *big.Int {
 	return new(big.Int).SetUint64(uint64(value))
 }
 
-func DecodeU8(buffer *bytes.Buffer) (U8, error) {
-	decoder := Decoder{Reader: buffer}
+func DecodeU8(reader io.Reader) (U8, error) {
+	decoder := Decoder{Reader: reader, Type: "U8"}
 	b, err := decoder.DecodeByte()
 	return U8(b), err
 }
+
+func (value *U8) Decode(reader io.Reader) error {
+	result, err := DecodeU8(reader)
+	if err != nil {
+		return err
+	}
+	*value = result
+	return nil
+}
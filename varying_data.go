@@ -7,8 +7,8 @@ package goscale
 */
 
 import (
-	"bytes"
 	"errors"
+	"io"
 	"math"
 )
 
@@ -30,9 +30,9 @@ func NewVaryingData(values ...Encodable) VaryingData {
 	return result
 }
 
-func (vd VaryingData) Encode(buffer *bytes.Buffer) error {
+func (vd VaryingData) Encode(writer io.Writer) error {
 	for _, v := range vd {
-		err := v.Encode(buffer)
+		err := v.Encode(writer)
 		if err != nil {
 			return err
 		}
@@ -40,13 +40,13 @@ func (vd VaryingData) Encode(buffer *bytes.Buffer) error {
 	return nil
 }
 
-func DecodeVaryingData(decodeFuncs []func(buffer *bytes.Buffer) []Encodable, buffer *bytes.Buffer) (VaryingData, error) {
+func DecodeVaryingData(decodeFuncs []func(reader io.Reader) []Encodable, reader io.Reader) (VaryingData, error) {
 	funcsLen := len(decodeFuncs)
 	if funcsLen > math.MaxUint8 {
 		return VaryingData{}, errExceedsU8Length
 	}
 
-	index, err := DecodeU8(buffer)
+	index, err := DecodeU8(reader)
 	if err != nil {
 		return VaryingData{}, err
 	}
@@ -54,7 +54,38 @@ func DecodeVaryingData(decodeFuncs []func(buffer *bytes.Buffer) []Encodable, buf
 		return VaryingData{}, errDecodingFuncNotFound
 	}
 
-	decoded := decodeFuncs[index](buffer)
+	decoded := decodeFuncs[index](reader)
+
+	args := make([]Encodable, 0, len(decoded)+1)
+	args = append(args, index)
+	args = append(args, decoded...)
+
+	return NewVaryingData(args...), nil
+}
+
+// VaryingDataDecodeFunc decodes the values following the index of a VaryingData.
+type VaryingDataDecodeFunc func(reader io.Reader) ([]Encodable, error)
+
+// DecodeVaryingDataSparse decodes the values with the decodeFuncs of their index, the indices can be sparse.
+// It fails with a VariantError if the index has no decode func or its decode func fails.
+func DecodeVaryingDataSparse(decodeFuncs map[U8]VaryingDataDecodeFunc, reader io.Reader) (VaryingData, error) {
+	index, err := DecodeU8(reader)
+	if err != nil {
+		return VaryingData{}, err
+	}
+
+	decodeFunc, ok := decodeFuncs[index]
+	if !ok {
+		return VaryingData{}, &VariantError{Index: index, Err: errDecodingFuncNotFound}
+	}
+
+	decoded, err := decodeFunc(reader)
+	if err != nil {
+		return VaryingData{}, &VariantError{Index: index, Err: Decoder{Reader: reader, Type: "VaryingData"}.Truncated(err)}
+	}
+	if len(decoded)+1 > math.MaxUint8 {
+		return VaryingData{}, &VariantError{Index: index, Err: errExceedsU8Length}
+	}
 
 	args := make([]Encodable, 0, len(decoded)+1)
 	args = append(args, index)
@@ -66,3 +97,11 @@ func DecodeVaryingData(decodeFuncs []func(buffer *bytes.Buffer) []Encodable, buf
 func (vd VaryingData) Bytes() []byte {
 	return EncodedBytes(vd)
 }
+
+func (vd VaryingData) EncodedLen() int {
+	size := 0
+	for _, v := range vd {
+		size += EncodedLen(v)
+	}
+	return size
+}
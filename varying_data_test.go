@@ -2,6 +2,7 @@ package goscale
 
 import (
 	"bytes"
+	"io"
 	"math"
 	"testing"
 
@@ -58,20 +59,20 @@ func Test_VaryingData_Decode(t *testing.T) {
 	var examples = []struct {
 		label       string
 		input       []byte
-		decodeFuncs []func(buffer *bytes.Buffer) []Encodable
+		decodeFuncs []func(reader io.Reader) []Encodable
 		expect      VaryingData
 	}{
 		{
 			label: "Decode VaryingData(U8, Bool)",
 			input: []byte{0x0, 0x2a},
-			decodeFuncs: []func(buffer *bytes.Buffer) []Encodable{
-				func(buffer *bytes.Buffer) []Encodable {
-					resultDecode, err := DecodeU8(buffer)
+			decodeFuncs: []func(reader io.Reader) []Encodable{
+				func(reader io.Reader) []Encodable {
+					resultDecode, err := DecodeU8(reader)
 					assert.NoError(t, err)
 					return []Encodable{resultDecode}
 				},
-				func(buffer *bytes.Buffer) []Encodable {
-					resultDecode, err := DecodeBool(buffer)
+				func(reader io.Reader) []Encodable {
+					resultDecode, err := DecodeBool(reader)
 					assert.NoError(t, err)
 					return []Encodable{resultDecode}
 				},
@@ -81,9 +82,9 @@ func Test_VaryingData_Decode(t *testing.T) {
 		{
 			label: "Decode VaryingData(U128, Empty)",
 			input: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
-			decodeFuncs: []func(buffer *bytes.Buffer) []Encodable{
-				func(buffer *bytes.Buffer) []Encodable {
-					resultDecode, err := DecodeU128(buffer)
+			decodeFuncs: []func(reader io.Reader) []Encodable{
+				func(reader io.Reader) []Encodable {
+					resultDecode, err := DecodeU128(reader)
 					assert.NoError(t, err)
 					return []Encodable{resultDecode}
 				},
@@ -93,13 +94,13 @@ func Test_VaryingData_Decode(t *testing.T) {
 		{
 			label: "Decode VaryingData(U64,U32,Sequence[U8])",
 			input: []byte{0x0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x4, 0x2a},
-			decodeFuncs: []func(buffer *bytes.Buffer) []Encodable{
-				func(buffer *bytes.Buffer) []Encodable {
-					resultDecodeU64, err := DecodeU64(buffer)
+			decodeFuncs: []func(reader io.Reader) []Encodable{
+				func(reader io.Reader) []Encodable {
+					resultDecodeU64, err := DecodeU64(reader)
 					assert.NoError(t, err)
-					resultDecodeU32, err := DecodeU32(buffer)
+					resultDecodeU32, err := DecodeU32(reader)
 					assert.NoError(t, err)
-					resultDecodeSeqU8, err := DecodeSequence[U8](buffer)
+					resultDecodeSeqU8, err := DecodeSequence[U8](reader)
 					assert.NoError(t, err)
 					return []Encodable{resultDecodeU64, resultDecodeU32, resultDecodeSeqU8}
 				},
@@ -109,21 +110,21 @@ func Test_VaryingData_Decode(t *testing.T) {
 		{
 			label: "Decode VaryingData(I8,U16,I16,CompactUint,CompactUint,I32,I64)",
 			input: []byte{0x0, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0b, 0x00, 0x40, 0x7a, 0x10, 0xf3, 0x5a, 0x14, 0x0, 0x0, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80},
-			decodeFuncs: []func(buffer *bytes.Buffer) []Encodable{
-				func(buffer *bytes.Buffer) []Encodable {
-					resultDecodeI8, err := DecodeI8(buffer)
+			decodeFuncs: []func(reader io.Reader) []Encodable{
+				func(reader io.Reader) []Encodable {
+					resultDecodeI8, err := DecodeI8(reader)
 					assert.NoError(t, err)
-					resultDecodeU16, err := DecodeU16(buffer)
+					resultDecodeU16, err := DecodeU16(reader)
 					assert.NoError(t, err)
-					resultDecodeI16, err := DecodeI16(buffer)
+					resultDecodeI16, err := DecodeI16(reader)
 					assert.NoError(t, err)
-					resultDecodeCompactOne, err := DecodeCompact[U128](buffer)
+					resultDecodeCompactOne, err := DecodeCompact[U128](reader)
 					assert.NoError(t, err)
-					resultDecodeCompactTwo, err := DecodeCompact[U128](buffer)
+					resultDecodeCompactTwo, err := DecodeCompact[U128](reader)
 					assert.NoError(t, err)
-					resultDecodeI32, err := DecodeI32(buffer)
+					resultDecodeI32, err := DecodeI32(reader)
 					assert.NoError(t, err)
-					resultDecodeI64, err := DecodeI64(buffer)
+					resultDecodeI64, err := DecodeI64(reader)
 					assert.NoError(t, err)
 					return []Encodable{resultDecodeI8, resultDecodeU16, resultDecodeI16, resultDecodeCompactOne, resultDecodeCompactTwo, resultDecodeI32, resultDecodeI64}
 				},
@@ -133,10 +134,10 @@ func Test_VaryingData_Decode(t *testing.T) {
 	}
 
 	for _, e := range examples {
-		buffer := &bytes.Buffer{}
-		buffer.Write(e.input)
+		reader := &bytes.Buffer{}
+		reader.Write(e.input)
 
-		result, err := DecodeVaryingData(e.decodeFuncs, buffer)
+		result, err := DecodeVaryingData(e.decodeFuncs, reader)
 
 		assert.NoError(t, err)
 		assert.Equal(t, e.expect, result)
@@ -144,19 +145,95 @@ func Test_VaryingData_Decode(t *testing.T) {
 }
 
 func Test_VaryingData_Decode_Error_ExceedsLength(t *testing.T) {
-	values := make([]func(buffer *bytes.Buffer) []Encodable, math.MaxUint8+1)
+	values := make([]func(reader io.Reader) []Encodable, math.MaxUint8+1)
 
 	_, err := DecodeVaryingData(values, &bytes.Buffer{})
 	assert.ErrorIs(t, errExceedsU8Length, err)
 }
 
 func Test_VaryingData_Decode_Error_Index_NotFound(t *testing.T) {
-	values := make([]func(buffer *bytes.Buffer) []Encodable, 1)
+	values := make([]func(reader io.Reader) []Encodable, 1)
 
-	buffer := &bytes.Buffer{}
-	buffer.Write(U8(1).Bytes())
+	reader := &bytes.Buffer{}
+	reader.Write(U8(1).Bytes())
 
-	_, err := DecodeVaryingData(values, buffer)
+	_, err := DecodeVaryingData(values, reader)
 
 	assert.ErrorIs(t, errDecodingFuncNotFound, err)
 }
+
+func Test_VaryingData_DecodeSparse(t *testing.T) {
+	decodeFuncs := map[U8]VaryingDataDecodeFunc{
+		0: func(reader io.Reader) ([]Encodable, error) {
+			return []Encodable{}, nil
+		},
+		7: func(reader io.Reader) ([]Encodable, error) {
+			value, err := DecodeU16(reader)
+			if err != nil {
+				return nil, err
+			}
+			seq, err := DecodeSequence[U8](reader)
+			if err != nil {
+				return nil, err
+			}
+			return []Encodable{value, seq}, nil
+		},
+	}
+
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect VaryingData
+	}{
+		{label: "Index 0", input: []byte{0x00}, expect: NewVaryingData(U8(0))},
+		{label: "Index 7", input: []byte{0x07, 0x2a, 0x00, 0x04, 0x01}, expect: NewVaryingData(U8(7), U16(42), Sequence[U8]{1})},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			result, err := DecodeVaryingDataSparse(decodeFuncs, bytes.NewBuffer(e.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, e.expect, result)
+			assert.Equal(t, e.input, result.Bytes())
+		})
+	}
+}
+
+func Test_VaryingData_DecodeSparse_Errors(t *testing.T) {
+	decodeFuncs := map[U8]VaryingDataDecodeFunc{
+		3: func(reader io.Reader) ([]Encodable, error) {
+			value, err := DecodeU32(reader)
+			return []Encodable{value}, err
+		},
+		4: func(reader io.Reader) ([]Encodable, error) {
+			return make([]Encodable, math.MaxUint8), nil
+		},
+	}
+
+	var examples = []struct {
+		label  string
+		input  []byte
+		expect error
+		index  U8
+	}{
+		{label: "Empty", input: []byte{}, expect: io.EOF},
+		{label: "Index not found", input: []byte{0x02}, expect: errDecodingFuncNotFound, index: 2},
+		{label: "Decode func error", input: []byte{0x03, 0x01}, expect: io.ErrUnexpectedEOF, index: 3},
+		{label: "Exceeds length", input: []byte{0x04}, expect: errExceedsU8Length, index: 4},
+	}
+
+	for _, e := range examples {
+		t.Run(e.label, func(t *testing.T) {
+			_, err := DecodeVaryingDataSparse(decodeFuncs, bytes.NewBuffer(e.input))
+
+			assert.ErrorIs(t, err, e.expect)
+			if variantErr, ok := err.(*VariantError); ok {
+				assert.Equal(t, e.index, variantErr.Index)
+			}
+		})
+	}
+
+	_, err := DecodeVaryingDataSparse(decodeFuncs, bytes.NewBuffer([]byte{0x03}))
+	assert.Equal(t, "variant 3: can not decode VaryingData: unexpected EOF", err.Error())
+}